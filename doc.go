@@ -5,28 +5,255 @@ to them.
 
 It adds all named files or files recursively under named directories at the
 path specified. The output file provides an http.FileSystem interface with
-zero dependencies on packages outside the standard library.
+zero dependencies on packages outside the standard library. Files are gzip
+compressed by default. With -compression brotli, which makes text smaller,
+or -compression zstd, which decompresses faster, the output imports
+github.com/andybalholm/brotli or github.com/klauspost/compress/zstd instead.
+Files compression does not make smaller, e.g. PNG images or woff2 fonts, are
+embedded uncompressed. Use -dual-storage or -no-compress for files read often
+at startup. Files with identical contents share a single embedded copy. A
+.escignore file at the root of an embedded directory lists paths in it not to
+embed, in the syntax of .gitignore.
 
 Usage:
 	esc [flag] [name ...]
 
 The flags are:
+	-config=""
+		JSON, YAML (.yaml, .yml) or TOML (.toml) file with the fields of
+		embed.Config, e.g. {"Package": "assets", "Files": ["static"]}; flags given
+		explicitly and file arguments override it
 	-o=""
 		output filename, defaults to stdout
 	-pkg="main"
 		package name of output file, defaults to main
+	-tags=""
+		build constraint expression, e.g. "enterprise && !oss", written as
+		//go:build line to the output file and the files written next to it
+	-dev-tag=""
+		build tag, e.g. dev, selecting a variant of the output reading the files
+		from disk, written next to it as e.g. static_dev.go; both ignore useLocal
+	-local-env=""
+		environment variable, e.g. ESC_USE_LOCAL, forcing local mode whatever the
+		useLocal arguments and -dev-tag when set to a true value such as 1, so
+		deployed builds can read files from disk without a rebuild
 	-prefix=""
-		strip given prefix from filenames
+		strip given prefix from filenames, which may be relative while the named
+		files are absolute or the other way around
+	-group=""
+		group of files, name=path, e.g. templates=./tmpl, embedded in /name with
+		functions named like it, e.g. TemplatesFS; may be repeated
+	-files=""
+		files embedded in a directory of their own, src=path:/dir, e.g.
+		src=./web/dist:/static, with path removed from their names; may be
+		repeated
+	-virtual=""
+		file embedded with generated contents, name=value, e.g.
+		/buildinfo.json=@git describe, where value is the contents or, after a
+		leading @, a command run without a shell whose output they are; a leading
+		@@ stands for @; may be repeated
 	-ignore=""
 		regular expression for files to ignore
 	-include=""
-		regular expression for files to include
+		regular expression for files to include, directories are kept either way
+	-ignore-glob=""
+		comma separated globs for files and directories to ignore, where a **
+		path element matches any number of path elements
+	-include-glob=""
+		comma separated globs for files to include
+	-ignore-file=""
+		file with regular expressions for files to ignore, one per line; blank
+		lines and lines starting with # are skipped
+	-include-file=""
+		file with regular expressions for files to include, one per line
+	-file-mode=""
+		octal permission bits, e.g. 0644, to override as mode for all files, which
+		otherwise keep their mode on disk
 	-modtime=""
-		Unix timestamp to override as modification time for all files
+		Unix timestamp or RFC 3339 time, e.g. 2024-05-01T12:00:00Z, to override as
+		modification time for all files, "now" or "build", the time of the last
+		commit, with an optional offset such as now-24h, or "git" for the time of
+		their last commit, defaults to $SOURCE_DATE_EPOCH
 	-private
 		unexport functions by prefixing them with esc, e.g. FS -> escFS
+	-func-prefix=""
+		prefix of the generated functions and types, e.g. Admin for AdminFS,
+		overriding -private
+	-ident-prefix=""
+		prefix replacing _esc in the unexported identifiers of the generated code,
+		e.g. _escAdmin, so the output of several runs of esc can share a package
 	-no-compress
 		do not compress files
+	-compression=""
+		format to compress files in: gzip, the default, brotli, which the output
+		decodes with github.com/andybalholm/brotli, or zstd, which it decodes with
+		github.com/klauspost/compress/zstd, so its module must require that;
+		FSGzipHandler serves the data with Content-Encoding br or zstd
+	-zero-copy
+		embed files uncompressed as string constants, which FSString returns and
+		FSByte shares read-only without copying, for files read often
+	-encrypt-key-env=""
+		environment variable holding a hex encoded AES key of 16, 24 or 32 bytes;
+		the compressed files are encrypted with it and the generated code decrypts
+		them with the key in the same variable at runtime or set with FSSetKey
+	-verify
+		check the content of every file against its SHA-256 hash when it is
+		decompressed, failing its reads with an error naming it if it is corrupted
+	-cache
+		cache compressed files by content in the user cache directory, e.g.
+		~/.cache/esc, so only changed files are compressed again
+	-import-path=""
+		full import path of the output package, checked against go.mod
+	-skip-module-check
+		do not check -import-path against go.mod
+	-root=""
+		record absolute local paths relative to this directory, defaults to the
+		directory of the enclosing go.mod
+	-absolute-paths
+		record absolute local paths and invocation arguments as given
+	-local-prefix=""
+		import path prefix goimports groups after third-party imports
+	-format-compat=""
+		format profile, empty for goimports or v1 for a copy of go/printer; see
+		Reproducible Output
+	-fingerprint
+		also embed files under names including their content version, e.g.
+		/app.0a286891.js, which FSHandler serves with immutable caching
+	-metadata-only
+		embed names, sizes and modification times but not contents, which are
+		loaded at runtime by the function registered with FSSetFetch
+	-minify=""
+		minifier of files of a media type, type=command, e.g.
+		text/css=minify --type=css, run without a shell with a file on standard
+		input before compressing it, or type for the built-in one of
+		application/json; may be repeated
+	-dual-storage=""
+		comma separated globs of files, by embedded name, to embed uncompressed as
+		well, so FSByte needs no decompression and FSGzipByte returns the gzip data
+	-precompressed-brotli
+		embed a file with a .br extension, e.g. app.js.br made by the brotli tool,
+		as the brotli variant of the file without it, which FSGzipHandler serves to
+		clients accepting brotli, instead of as a file
+	-interface
+		also generate the FSAssets interface, FSInstance implementing it with the
+		embedded assets and NewFSFake implementing it in memory for tests
+	-parse-templates
+		also generate ParseTemplates parsing embedded files as html/template
+		templates named by their canonical names
+	-path-constants
+		also generate a constant holding the canonical name of every embedded
+		file, e.g. FileIndexHTML for /index.html
+	-mutable-metadata
+		add FSSetModTime and FSResetModTimes to override modification times at
+		runtime, e.g. to test cache validation
+	-wrap-embed-var=""
+		embed no contents but read them from this embed.FS variable in the output
+		package; FSSelfCheck reports files differing from its go:embed patterns
+	-go-embed
+		write file contents to a data directory next to the output file, e.g.
+		static_data, and embed them with go:embed; requires -o
+	-shard-size=0
+		if positive, move embedded data to <output>_000.go, <output>_001.go and so
+		on next to the output file, each holding about this many bytes, to keep
+		generated files small enough for editors; requires -o
+	-conformance
+		also write <output>_conformance_test.go checking the generated filesystems
+		against a manifest of the embedded files with package esctest
+	-examples
+		also write <output>_example_test.go with runnable examples of FS, FSMustByte
+		and Dir for an embedded file
+	-json-manifest
+		also write <output>_manifest.json with the names, sizes, SHA-256 hashes,
+		modification times and content types of the embedded files
+	-afero
+		also write <output>_afero.go with AferoFs, adapting the assets to a
+		read-only afero.Fs of github.com/spf13/afero v1.9 or later
+	-billy
+		also write <output>_billy.go with BillyFS, copying the assets to a new
+		in-memory billy.Filesystem of github.com/go-git/go-billy/v5, e.g. for
+		go-git repositories in tests
+	-invocation-limit=0
+		truncate the invocation recorded in the output to this length by eliding
+		file arguments, defaults to 1000; negative disables truncation
+	-expand-archives=""
+		comma separated globs of .zip, .tar, .tar.gz and .tgz files, by embedded
+		name, to expand in place instead of embedding them as files; deflated zip
+		members keep their compressed data instead of being compressed again
+	-keep-archive-name
+		mount expanded archives in a directory named like the archive without its
+		extension instead of in the directory of the archive
+	-lookup-mode=""
+		how the output looks up embedded names: map, the default, binary-search,
+		which omits the map and its keys for outputs with very many files, or
+		compact, which also stores all names and all local paths in one string each
+	-case-insensitive
+		also find embedded files under names differing in case, e.g. /Logo.PNG for
+		/logo.png, failing if two names differ in case only
+	-normalize=""
+		Unicode normalization form of embedded names, nfc, which lookups normalize
+		names to as well, so files named on macOS are found under names written
+		elsewhere
+	-check
+		write nothing but exit with a summary of the differences if the output file
+		or the files written next to it are not up to date, e.g. in CI; requires -o
+	-watch
+		regenerate the output file whenever the embedded files change, until
+		interrupted; requires -o. The directories of local files are watched with
+		fsnotify, and a burst of changes regenerates the output once it settles
+	-report
+		print the size, embedded size and their ratio of every file, largest
+		embedded size first, and the totals on standard error
+	-skip-hidden
+		skip files and directories starting with a dot, such as .git, .idea or
+		.DS_Store, in embedded directories
+	-gitignore
+		skip files and directories in embedded directories matched by the
+		.gitignore files found in them, such as build artifacts and editor swap
+		files
+	-max-depth=0
+		if positive, how many levels to descend beneath every named directory: 1
+		embeds the files in it only, 2 also those in its subdirectories
+	-symlinks=""
+		what to do with symlinks in embedded directories: follow, the default,
+		which fails on symlinks back to a directory being embedded, skip or error
+	-encoding=""
+		how compressed data is written in the output: base64, the default,
+		string, quoted string literals which save decoding base64 and a quarter of
+		the data in the binary but make the output larger, packed, string with
+		the data of all files in one literal, which compiles faster, or sidecar,
+		packed with the data in a .bin file next to the output file embedded with
+		go:embed, which leaves only the index in the output; requires -o
+	-strict-keys
+		fail instead of warning if an -expand-archives glob matches no embedded file
+	-max-file-size=0
+		if positive, warn about embedded files larger than this many bytes, e.g.
+		to notice node_modules embedded by accident
+	-max-total-size=0
+		if positive, warn if the embedded files are larger than this many bytes
+		together
+	-strict-sizes
+		fail instead of warning if -max-file-size or -max-total-size is exceeded
+
+	esc extract [-o dir] file.go
+
+writes the files embedded in an output file of esc, with its shards, sidecar
+data and go:embed data directory, to a directory, default the current one,
+e.g. to inspect what shipped in a release. It builds and runs them with the
+go command, so encrypted outputs need their key in the environment. Outputs
+of -wrap-embed-var cannot be extracted, as their embed.FS variable is
+declared elsewhere in the package.
+
+	esc diff [flag] [name ...]
+
+lists the files the same esc [flag] [name ...] would add to, remove from or
+change in the existing output file, one per line as e.g. "changed
+/index.html", without writing anything, and exits with status 1 if there are
+any. It reads the output file like esc extract.
+
+Names starting with https:// are downloaded when generating and embedded
+like files without a local path. The fragment pins the content to its
+SHA-256 and may set the embedded name, which defaults to the base name of the
+URL, e.g. https://cdn.example.com/lib.js#sha256=<hex>&name=/vendor/lib.js.
 
 Accessing Embedded Files
 
@@ -36,6 +263,77 @@ development).
 
 FS(Must)?(Byte|String) returns an asset as a (byte slice|string).
 FSMust(Byte|String) panics if the asset is not found.
+FSStat returns information about an asset without loading it.
+FSGzipByte returns the embedded gzip data of a compressed asset, e.g. to
+proxy it to clients accepting gzip without decompressing it.
+FSVersion returns a short content derived token for an asset, and
+FSVersionedPath its name with that token as "v" query parameter.
+FSFingerprinted returns the fingerprinted name of an asset embedded with
+-fingerprint, e.g. as template function, and FSManifest all of them by
+canonical name.
+FSContentType returns the MIME type of an asset detected when it was
+embedded from its extension or content.
+FSHash returns the SHA-256 of an asset computed when it was embedded.
+FSInstallDefaults writes assets to disk unless the destination exists.
+FSHandler serves assets like http.FileServer, with Cache-Control and ETag
+headers, and with a Fallback option, e.g. /index.html, for the routes of
+single-page apps outside excluded prefixes such as /api, or without
+directory listings with DisableListing.
+FSGzipHandler serves assets like FSHandler, but compressed ones as their
+embedded gzip data, or brotli variant with -precompressed-brotli, to clients
+accepting it, without decompressing them.
+FSServeFile serves a single asset, e.g. /favicon.ico, with range and
+conditional request support.
+FSRestricted returns a filesystem serving only an allowlist of names and
+patterns.
+FSTree returns the embedded files and directories as a tree.
+FSSetLocalRoot makes local mode read files from another directory, e.g.
+a vendored copy of the assets.
+FSRelPath returns the relative URL path between two assets, e.g. for links
+that work wherever the assets are mounted.
+IOFS returns the assets as an io/fs.FS, with names relative to the root,
+e.g. for template.ParseFS or http.FS.
+FSGlob returns the embedded names matching a pattern, where two stars match
+any number of path elements.
+FSNames and FSDirNames return the sorted canonical names of all embedded
+files and directories.
+FSPreloadAll decompresses all embedded files up front, e.g. at startup,
+instead of on their first read.
+FSSetKey sets the key decrypting the embedded files, with -encrypt-key-env,
+instead of the environment variable.
+FSWalk walks the embedded tree in sorted order like fs.WalkDir, with
+canonical names.
+ParseTemplates parses the embedded files matching FSGlob patterns as
+html/template templates with a FuncMap, with -parse-templates.
+<Group>FS, <Group>IOFS and <Group>FS(Must)?(Byte|String) are those functions
+for the files of a -group, e.g. TemplatesFS for -group templates=./tmpl.
+
+Directory listings, whether from Readdir or any other function enumerating
+assets, are sorted by name, comparing bytes, for both embedded and local
+assets. Files opened from FS, IOFS or FSRestricted implement io.ReaderAt, so
+an embedded archive can be read with zip.NewReader without copying it.
+
+Reproducible Output
+
+esc writes the same output for the same files and flags, and the output
+only changes with esc itself. With -format-compat=v1 it is also
+byte-identical whatever Go version esc is built with: it is formatted with
+a copy of go/printer in esc, without goimports, and gzip data is written by
+esc, stored with -no-compress and otherwise deflated by
+github.com/klauspost/compress at the version esc requires, not by
+compress/flate. Without -format-compat=v1, goimports and compress/flate of
+the Go version esc is built with may change the output.
+
+Large Files
+
+Files of 64 MiB or more are not held in memory. esc hashes them while
+collecting the files and reads them again while writing the output,
+compressing and base64 encoding them straight into it, and fails if one
+changed in between. Smaller files, and all files with options that need
+their contents before the output is written, are read and compressed in
+memory: -encoding other than base64, -no-compress, -zero-copy, -go-embed,
+-wrap-embed-var, -metadata-only, -shard-size, -dual-storage, -minify,
+-encrypt-key-env and -precompressed-brotli.
 
 Go Generate
 
@@ -0,0 +1,152 @@
+package embed
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// adapter is a file adapting the generated functions to the interfaces of a
+// third-party package. Adapters are written apart from the output, which
+// depends on the standard library only.
+type adapter struct {
+	// kind names the adapter in errors and, as suffix, its file, e.g.
+	// "afero" for static_afero.go.
+	kind string
+	tmpl *template.Template
+}
+
+var (
+	aferoAdapter = adapter{"afero", template.Must(template.New("").Parse(aferoTemplate))}
+	billyAdapter = adapter{"billy", template.Must(template.New("").Parse(billyTemplate))}
+)
+
+// adapters returns the adapters selected by the Config of p.
+func (p *Plan) adapters() []adapter {
+	var adapters []adapter
+	if p.conf.Afero {
+		adapters = append(adapters, aferoAdapter)
+	}
+	if p.conf.Billy {
+		adapters = append(adapters, billyAdapter)
+	}
+	return adapters
+}
+
+// fileName returns the name of the adapter written next to outputFile.
+func (a adapter) fileName(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_" + a.kind + ".go"
+}
+
+// source returns the adapter for the output of p.
+func (a adapter) source(p *Plan, invocation, functionPrefix string) ([]byte, error) {
+	if p.conf.OutputFile == "" {
+		return nil, errors.Errorf("the %s adapter requires an output file", a.kind)
+	}
+	var buf bytes.Buffer
+	if err := a.tmpl.Execute(&buf, map[string]interface{}{
+		"Invocation":     invocation,
+		"PackageName":    p.conf.Package,
+		"BuildTags":      p.conf.BuildTags,
+		"FunctionPrefix": functionPrefix,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "%s template execution", a.kind)
+	}
+	data, err := formatFile(p.conf, renameIdents(buf.Bytes(), p.conf.IdentPrefix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "format %s adapter", a.kind)
+	}
+	return data, nil
+}
+
+const aferoTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// {{.FunctionPrefix}}AferoFs returns the embedded assets as a read-only afero.Fs with canonical
+// names such as "/css/main.css". If useLocal is true, the filesystem's
+// contents are instead used.
+func {{.FunctionPrefix}}AferoFs(useLocal bool) afero.Fs {
+	return afero.FromIOFS{FS: _escAferoFS{ {{- .FunctionPrefix}}IOFS(useLocal)}}
+}
+
+// _escAferoFS opens the rooted names afero uses in an io/fs.FS.
+type _escAferoFS struct {
+	fs fs.FS
+}
+
+func (f _escAferoFS) Open(name string) (fs.File, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+	return f.fs.Open(name)
+}
+`
+
+const billyTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"io/fs"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+)
+
+// {{.FunctionPrefix}}BillyFS returns a new in-memory billy.Filesystem holding a copy of the
+// embedded assets, e.g. as worktree of a go-git repository in tests. If
+// useLocal is true, the filesystem's contents are instead used.
+func {{.FunctionPrefix}}BillyFS(useLocal bool) (billy.Filesystem, error) {
+	fsys := {{.FunctionPrefix}}IOFS(useLocal)
+	mem := memfs.New()
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || name == "." {
+			return err
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		mode := fi.Mode().Perm()
+		if d.IsDir() {
+			if mode == 0 {
+				mode = 0o755
+			}
+			return mem.MkdirAll(name, mode)
+		}
+		if mode == 0 {
+			mode = 0o644
+		}
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		return util.WriteFile(mem, name, b, mode)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mem, nil
+}
+`
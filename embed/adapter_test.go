@@ -0,0 +1,200 @@
+package embed
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// aferoStub is the part of github.com/spf13/afero the adapter uses, with
+// FromIOFS passing names through like afero does.
+const aferoStub = `package afero
+
+import (
+	"io/fs"
+	"os"
+)
+
+type File interface {
+	fs.File
+}
+
+type Fs interface {
+	Open(name string) (File, error)
+	Stat(name string) (os.FileInfo, error)
+	Name() string
+}
+
+type FromIOFS struct {
+	fs.FS
+}
+
+func (f FromIOFS) Open(name string) (File, error) { return f.FS.Open(name) }
+
+func (f FromIOFS) Stat(name string) (os.FileInfo, error) { return fs.Stat(f.FS, name) }
+
+func (FromIOFS) Name() string { return "fromiofs" }
+`
+
+func TestAfero(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"index.html":   "<html></html>",
+		"css/main.css": "body{}",
+	})
+	output := filepath.Join(t.TempDir(), "static.go")
+	conf := &Config{
+		OutputFile: output,
+		Package:    "main",
+		Prefix:     root,
+		Files:      []string{root},
+		Afero:      true,
+	}
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(output), "static_afero.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := map[string]string{
+		"go.mod":          "module esctest\n\ngo 1.18\n\nrequire github.com/spf13/afero v1.9.0\n\nreplace github.com/spf13/afero => ./afero\n",
+		"afero/go.mod":    "module github.com/spf13/afero\n\ngo 1.18\n",
+		"afero/afero.go":  aferoStub,
+		"static_afero.go": string(b),
+		"afero_test.go": `package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestAfero(t *testing.T) {
+	fs := AferoFs(false)
+	f, err := fs.Open("/css/main.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(f)
+	fi, err := fs.Stat("/css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("afero", string(b), fi.IsDir())
+}
+`,
+	}
+	if out := runGenerated(t, conf, sources, "test", "-v", "-mod=mod", "."); !strings.Contains(out, "afero body{} true") {
+		t.Errorf("go test:\n%s\nwant the files through afero", out)
+	}
+
+	conf.OutputFile = ""
+	if err := Run(conf, ioutil.Discard); err == nil {
+		t.Error("Run() with Afero and no output file must err")
+	}
+}
+
+// billyStubs are the parts of github.com/go-git/go-billy/v5 the adapter
+// uses, with a map for memfs.
+var billyStubs = map[string]string{
+	"billy/go.mod": "module github.com/go-git/go-billy/v5\n\ngo 1.18\n",
+	"billy/billy.go": `package billy
+
+import "os"
+
+type Filesystem interface {
+	MkdirAll(name string, perm os.FileMode) error
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	ReadFile(name string) ([]byte, error)
+}
+`,
+	"billy/memfs/memfs.go": `package memfs
+
+import (
+	"os"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+type memory map[string][]byte
+
+func New() billy.Filesystem { return memory{} }
+
+func (m memory) MkdirAll(name string, perm os.FileMode) error {
+	m[name+"/"] = nil
+	return nil
+}
+
+func (m memory) WriteFile(name string, data []byte, perm os.FileMode) error {
+	m[name] = data
+	return nil
+}
+
+func (m memory) ReadFile(name string) ([]byte, error) {
+	b, ok := m[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return b, nil
+}
+`,
+	"billy/util/util.go": `package util
+
+import (
+	"os"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+func WriteFile(fs billy.Filesystem, name string, data []byte, perm os.FileMode) error {
+	return fs.WriteFile(name, data, perm)
+}
+`,
+}
+
+func TestBilly(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"fixture/README":   "readme",
+		"fixture/src/a.go": "package a",
+	})
+	output := filepath.Join(t.TempDir(), "static.go")
+	conf := &Config{
+		OutputFile: output,
+		Package:    "main",
+		Prefix:     filepath.Join(root, "fixture"),
+		Files:      []string{filepath.Join(root, "fixture")},
+		Billy:      true,
+	}
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(output), "static_billy.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := map[string]string{
+		"go.mod":          "module esctest\n\ngo 1.18\n\nrequire github.com/go-git/go-billy/v5 v5.4.0\n\nreplace github.com/go-git/go-billy/v5 => ./billy\n",
+		"static_billy.go": string(b),
+		"billy_test.go": `package main
+
+import "testing"
+
+func TestBilly(t *testing.T) {
+	fs, err := BillyFS(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := fs.ReadFile("src/a.go")
+	t.Log("billy", string(b), err)
+}
+`,
+	}
+	for name, src := range billyStubs {
+		sources[name] = src
+	}
+	if out := runGenerated(t, conf, sources, "test", "-v", "-mod=mod", "."); !strings.Contains(out, "billy package a <nil>") {
+		t.Errorf("go test:\n%s\nwant the files copied to memfs", out)
+	}
+}
@@ -0,0 +1,299 @@
+package embed
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// archiveExts are the extensions of the archives that can be expanded, longest
+// first.
+var archiveExts = []string{".tar.gz", ".tgz", ".tar", ".zip"}
+
+// pendingArchive is an archive found during the walk that is expanded after
+// it.
+type pendingArchive struct {
+	// Name is the canonical name of the archive itself.
+	Name string
+	// File is the file name to read it from.
+	File string
+	// Local is its local path.
+	Local string
+	// FS, if set, is read instead of File and mounted at "/", see RunFS.
+	FS fs.FS
+}
+
+// fsLocal is the local path recorded for the files of the fs.FS given to
+// RunFS. Like archive members, they are served embedded in local mode.
+const fsLocal = "fs.FS"
+
+// archiveMember is a file or directory in an archive.
+type archiveMember struct {
+	// Path is the cleaned, slash separated and relative path in the archive.
+	Path    string
+	IsDir   bool
+	ModTime int64
+	Mode    os.FileMode
+	Data    []byte
+	// Deflated is the raw deflate data of a zip member compressed with
+	// zip.Deflate, and CRC32 the checksum of Data.
+	Deflated []byte
+	CRC32    uint32
+}
+
+// archiveMount returns the directory the members of the archive with the
+// canonical name are mounted under: the directory of the archive, or with
+// keepName a directory named like the archive without its extension.
+func archiveMount(name string, keepName bool) string {
+	if !keepName {
+		return path.Dir(name)
+	}
+	for _, ext := range archiveExts {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
+// readArchive returns the members of the zip or tar archive fname, sorted
+// by path.
+func readArchive(fname string) ([]archiveMember, error) {
+	var members []archiveMember
+	var err error
+	switch {
+	case strings.HasSuffix(fname, ".zip"):
+		members, err = readZip(fname)
+	case strings.HasSuffix(fname, ".tar"):
+		members, err = readTar(fname, false)
+	case strings.HasSuffix(fname, ".tar.gz"), strings.HasSuffix(fname, ".tgz"):
+		members, err = readTar(fname, true)
+	default:
+		return nil, fmt.Errorf("%s: unknown archive format, want one of %s", fname, strings.Join(archiveExts, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", fname, err)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Path < members[j].Path })
+	return members, nil
+}
+
+// readFS returns the files and directories of fsys as members, sorted by
+// path.
+func readFS(fsys fs.FS) ([]archiveMember, error) {
+	var members []archiveMember
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return fmt.Errorf("%s is not a regular file or directory", p)
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		m := archiveMember{Path: p, IsDir: d.IsDir(), ModTime: fi.ModTime().Unix(), Mode: fi.Mode().Perm()}
+		if !m.IsDir {
+			if m.Data, err = fs.ReadFile(fsys, p); err != nil {
+				return err
+			}
+		}
+		members = append(members, m)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", fsLocal, err)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Path < members[j].Path })
+	return members, nil
+}
+
+// memberPath cleans the path of an archive member, rejecting paths that
+// would leave the directory the archive is mounted under.
+func memberPath(name string) (string, error) {
+	p := path.Clean(strings.TrimSuffix(name, "/"))
+	if path.IsAbs(name) || p == ".." || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("member %s is outside the archive", name)
+	}
+	return p, nil
+}
+
+func readZip(fname string) ([]archiveMember, error) {
+	zr, err := zip.OpenReader(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var members []archiveMember
+	for _, zf := range zr.File {
+		p, err := memberPath(zf.Name)
+		if err != nil {
+			return nil, err
+		}
+		m := archiveMember{Path: p, IsDir: zf.FileInfo().IsDir(), ModTime: zf.Modified.Unix(), Mode: zf.Mode().Perm()}
+		if !m.IsDir && !zf.Mode().IsRegular() {
+			return nil, fmt.Errorf("member %s is not a regular file or directory", zf.Name)
+		}
+		if !m.IsDir {
+			rc, err := zf.Open()
+			if err != nil {
+				return nil, err
+			}
+			m.Data, err = ioutil.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !m.IsDir && zf.Method == zip.Deflate {
+			// The data is checked against the checksum while reading it
+			// above, so the raw data is known to inflate to it.
+			r, err := zf.OpenRaw()
+			if err != nil {
+				return nil, err
+			}
+			if m.Deflated, err = ioutil.ReadAll(r); err != nil {
+				return nil, err
+			}
+			m.CRC32 = zf.CRC32
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func readTar(fname string, gzipped bool) ([]archiveMember, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if gzipped {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	tr := tar.NewReader(r)
+	var members []archiveMember
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return members, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		p, err := memberPath(hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+		m := archiveMember{Path: p, ModTime: hdr.ModTime.Unix(), Mode: hdr.FileInfo().Mode().Perm()}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			m.IsDir = true
+		case tar.TypeReg:
+			if m.Data, err = ioutil.ReadAll(tr); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("member %s is not a regular file or directory", hdr.Name)
+		}
+		members = append(members, m)
+	}
+}
+
+// expandArchive returns the files and directories of the archive a mounted
+// as configured by conf. Files have their Data set but are not prepared
+// otherwise. Directories list their children within the archive only.
+func expandArchive(a pendingArchive, conf *Config, modTimes *modTimes) ([]*_escFile, []*_escDir, error) {
+	var members []archiveMember
+	var err error
+	mount, keepName := archiveMount(a.Name, conf.KeepArchiveName), conf.KeepArchiveName
+	if a.FS != nil {
+		members, err = readFS(a.FS)
+		mount, keepName = "/", true
+	} else {
+		members, err = readArchive(a.File)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	dirs := make(map[string]*_escDir)
+	var dirList []*_escDir
+	var dir func(name string) *_escDir
+	dir = func(name string) *_escDir {
+		if d, ok := dirs[name]; ok {
+			return d
+		}
+		d := &_escDir{
+			Name:     name,
+			BaseName: path.Base(name),
+			Local:    a.Local + "/" + strings.TrimPrefix(strings.TrimPrefix(name, mount), "/"),
+			Archive:  a.Local,
+		}
+		dirs[name] = d
+		dirList = append(dirList, d)
+		if name != mount && (keepName || path.Dir(name) != mount) {
+			parent := dir(path.Dir(name))
+			parent.ChildFileNames = append(parent.ChildFileNames, name)
+		}
+		return d
+	}
+	if keepName {
+		dir(mount)
+	}
+	var files []*_escFile
+	for _, m := range members {
+		if m.Path == "." {
+			continue
+		}
+		n := path.Join(mount, m.Path)
+		if m.IsDir {
+			dir(n)
+			continue
+		}
+		parent := path.Dir(n)
+		if parent != mount || keepName {
+			d := dir(parent)
+			d.ChildFileNames = append(d.ChildFileNames, n)
+		}
+		f := &_escFile{
+			Name:     n,
+			BaseName: path.Base(n),
+			Data:     m.Data,
+			Size:     int64(len(m.Data)),
+			Local:    a.Local + "/" + m.Path,
+			Archive:  a.Local,
+			ModTime:  m.ModTime,
+			Mode:     m.Mode,
+			deflated: m.Deflated,
+			crc32:    m.CRC32,
+		}
+		if a.FS != nil {
+			// There is no file to look up the time of the last commit of.
+			if modTimes.fixed != nil {
+				f.ModTime = *modTimes.fixed
+			}
+		} else if f.uncommitted, err = modTimes.set(f, a.File); err != nil {
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+	for _, d := range dirList {
+		sort.Strings(d.ChildFileNames)
+	}
+	return files, dirList, nil
+}
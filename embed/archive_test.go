@@ -0,0 +1,304 @@
+package embed
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeZip writes a zip archive with files (slash separated name → content)
+// to fname.
+func writeZip(t *testing.T, fname string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeTar writes a tar archive with files (slash separated name → content)
+// to fname.
+func writeTar(t *testing.T, fname string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tw := tar.NewWriter(f)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExpandArchives(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"web/index.html": "<html></html>"})
+	writeZip(t, filepath.Join(root, "web", "lib.zip"), map[string]string{
+		"lib.js":       "lib()",
+		"css/lib.css":  "body{}",
+		"css/more.css": "p{}",
+	})
+	writeTar(t, filepath.Join(root, "web", "fonts.tar"), map[string]string{"a.woff": "woff"})
+
+	tests := []struct {
+		name   string
+		expand []string
+		keep   bool
+		want   string
+	}{
+		{"opaque", nil, false, `/ dir=true children=1
+/web dir=true children=3
+/web/fonts.tar dir=false children=0
+/web/index.html dir=false children=0
+/web/lib.zip dir=false children=0
+`},
+		{"expanded with strip", []string{"/web/*.zip", "/web/fonts.tar"}, false, `/ dir=true children=1
+/web dir=true children=4
+/web/a.woff dir=false children=0
+/web/css dir=true children=2
+/web/css/lib.css dir=false children=0
+/web/css/more.css dir=false children=0
+/web/index.html dir=false children=0
+/web/lib.js dir=false children=0
+`},
+		{"expanded with keep", []string{"/web/lib.zip"}, true, `/ dir=true children=1
+/web dir=true children=3
+/web/fonts.tar dir=false children=0
+/web/index.html dir=false children=0
+/web/lib dir=true children=2
+/web/lib/css dir=true children=2
+/web/lib/css/lib.css dir=false children=0
+/web/lib/css/more.css dir=false children=0
+/web/lib/lib.js dir=false children=0
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Collect(&Config{
+				Package:         "main",
+				Prefix:          root,
+				Files:           []string{root},
+				ExpandArchives:  tt.expand,
+				KeepArchiveName: tt.keep,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b strings.Builder
+			p.Tree().Walk(func(n *Node) error {
+				fmt.Fprintf(&b, "%s dir=%t children=%d\n", n.Name, n.IsDir, len(n.Children))
+				return nil
+			})
+			if got := b.String(); got != tt.want {
+				t.Errorf("Tree() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandArchivesCollision(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"web/lib.js": "real()"})
+	writeZip(t, filepath.Join(root, "web", "lib.zip"), map[string]string{"lib.js": "lib()"})
+	_, err := Collect(&Config{
+		Package:        "main",
+		Prefix:         root,
+		Files:          []string{root},
+		ExpandArchives: []string{"/web/lib.zip"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "/web/lib.js: expanded from") {
+		t.Errorf("Collect() error = %v, want a collision with /web/lib.js", err)
+	}
+}
+
+func TestExpandArchivesLocal(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"web/index.html": "<html></html>"})
+	writeZip(t, filepath.Join(root, "web", "lib.zip"), map[string]string{"css/lib.css": "body{}"})
+	conf := &Config{
+		Package:        "main",
+		Prefix:         root,
+		Files:          []string{root},
+		ExpandArchives: []string{"/web/lib.zip"},
+	}
+	runGenerated(t, conf, map[string]string{"static_test.go": `package main
+
+import "testing"
+
+func TestArchive(t *testing.T) {
+	for _, useLocal := range []bool{false, true} {
+		if s, err := FSString(useLocal, "/web/css/lib.css"); err != nil || s != "body{}" {
+			t.Errorf("FSString(%t) = %q, %v, want body{}", useLocal, s, err)
+		}
+		d, err := FS(useLocal).Open("/web/css")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fis, err := d.Readdir(-1)
+		if err != nil || len(fis) != 1 || fis[0].Name() != "lib.css" {
+			t.Errorf("FS(%t) Readdir() = %v, %v, want lib.css", useLocal, fis, err)
+		}
+	}
+}
+`}, "test", ".")
+}
+
+func TestExpandZipMembers(t *testing.T) {
+	root := t.TempDir()
+	fname := filepath.Join(root, "lib.zip")
+	f, err := os.Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	content := strings.Repeat("deflated ", 100)
+	for _, h := range []*zip.FileHeader{
+		{Name: "empty/", Method: zip.Store},
+		{Name: "stored.txt", Method: zip.Store},
+		{Name: "deflated.txt", Method: zip.Deflate},
+	} {
+		w, err := zw.CreateHeader(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(h.Name, "/") {
+			w.Write([]byte(content))
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	conf := &Config{Package: "main", Prefix: root, Files: []string{fname}, ExpandArchives: []string{"/lib.zip"}}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range p.files {
+		names = append(names, f.Name)
+		gr, err := gzip.NewReader(bytes.NewReader(f.GzipData))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, err := ioutil.ReadAll(gr); err != nil || string(b) != content {
+			t.Errorf("%s: gzip data inflates to %.20q, %v", f.Name, b, err)
+		}
+		if reused := f.deflated != nil; reused != (f.Name == "/deflated.txt") {
+			t.Errorf("%s: deflate data reused = %t", f.Name, reused)
+		}
+	}
+	if want := []string{"/deflated.txt", "/stored.txt"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Collect() embedded %q, want %q", names, want)
+	}
+	if d := p.dirs[len(p.dirs)-1]; d.Name != "/empty" {
+		t.Errorf("Collect() embedded the directory %s, want /empty", d.Name)
+	}
+
+	f, err = os.Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw = zip.NewWriter(f)
+	h := &zip.FileHeader{Name: "link"}
+	h.SetMode(os.ModeSymlink | 0777)
+	if w, err := zw.CreateHeader(h); err != nil {
+		t.Fatal(err)
+	} else {
+		w.Write([]byte("stored.txt"))
+	}
+	zw.Close()
+	f.Close()
+	if _, err := Collect(conf); err == nil || !strings.Contains(err.Error(), "not a regular file") {
+		t.Errorf("Collect() with a symlink member = %v, want an error", err)
+	}
+}
+
+func TestReaderAt(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "web"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeZip(t, filepath.Join(root, "web", "bundle.zip"), map[string]string{"a.txt": "member"})
+	conf := &Config{
+		Package: "main",
+		Files:   []string{filepath.Join(root, "web")},
+		Prefix:  filepath.Join(root, "web"),
+	}
+	sources := map[string]string{"readerat_test.go": `package main
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"testing"
+)
+
+func TestReaderAt(t *testing.T) {
+	restricted, err := FSRestricted(false, "/bundle.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, open := range map[string]func() (fs.File, error){
+		"FS":           func() (fs.File, error) { return FS(false).Open("/bundle.zip") },
+		"IOFS":         func() (fs.File, error) { return IOFS(false).Open("bundle.zip") },
+		"FSRestricted": func() (fs.File, error) { return restricted.Open("/bundle.zip") },
+	} {
+		f, err := open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fi, _ := f.Stat()
+		zr, err := zip.NewReader(f.(io.ReaderAt), fi.Size())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		rc, err := zr.Open("a.txt")
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		b, _ := ioutil.ReadAll(rc)
+		t.Log(name, "read", string(b))
+		f.Close()
+	}
+}
+`}
+	out := runGenerated(t, conf, sources, "test", "-v", ".")
+	for _, name := range []string{"FS", "IOFS", "FSRestricted"} {
+		if !strings.Contains(out, name+" read member") {
+			t.Errorf("go test:\n%s\nwant %s to read the zip member", out, name)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package embed
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// brotliExt is the extension of the brotli compressed variants of files
+// embedded with Config.PrecompressedBrotli.
+const brotliExt = ".br"
+
+// checkBrotli validates the Config of precompressed brotli variants.
+func checkBrotli(conf *Config) error {
+	if conf.PrecompressedBrotli && (conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed) {
+		return errors.New("precompressed brotli variants require embedded file contents")
+	}
+	return nil
+}
+
+// attachBrotli moves the data of every file of files named like another one
+// with brotliExt appended to the Brotli field of that file, and removes it
+// from files and the listing of its directory in dirs.
+func attachBrotli(files []*_escFile, dirs map[string]*_escDir) []*_escFile {
+	byName := make(map[string]*_escFile, len(files))
+	for _, f := range files {
+		byName[f.Name] = f
+	}
+	kept := files[:0]
+	for _, f := range files {
+		target, ok := byName[strings.TrimSuffix(f.Name, brotliExt)]
+		if !strings.HasSuffix(f.Name, brotliExt) || !ok {
+			kept = append(kept, f)
+			continue
+		}
+		target.Brotli = f.Data
+		if d, ok := dirs[path.Dir(f.Name)]; ok {
+			children := d.ChildFileNames[:0]
+			for _, c := range d.ChildFileNames {
+				if c != f.Name {
+					children = append(children, c)
+				}
+			}
+			d.ChildFileNames = children
+		}
+	}
+	return kept
+}
+
+// hasBrotli reports whether any file of p has a brotli variant.
+func (p *Plan) hasBrotli() bool {
+	for _, f := range p.files {
+		if f.Brotli != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package embed
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkBudgets reports the files larger than Config.MaxFileSize and all
+// files together being larger than Config.MaxTotalSize. With
+// Config.StrictSizes they are returned as one error, else warned about.
+func (p *Plan) checkBudgets() error {
+	type excess struct {
+		code WarningCode
+		path string
+		msg  string
+	}
+	var excesses []excess
+	var total int64
+	for _, f := range p.files {
+		total += f.Size
+		if max := p.conf.MaxFileSize; max > 0 && f.Size > max {
+			excesses = append(excesses, excess{WarningFileSize, f.Name,
+				fmt.Sprintf("%s: %d bytes exceed MaxFileSize of %d bytes", f.Name, f.Size, max)})
+		}
+	}
+	if max := p.conf.MaxTotalSize; max > 0 && total > max {
+		excesses = append(excesses, excess{WarningTotalSize, "",
+			fmt.Sprintf("%d bytes in %d files exceed MaxTotalSize of %d bytes", total, len(p.files), max)})
+	}
+	if len(excesses) == 0 {
+		return nil
+	}
+	if !p.conf.StrictSizes {
+		for _, e := range excesses {
+			p.warn(e.code, e.path, "%s", e.msg)
+		}
+		return nil
+	}
+	msgs := make([]string, 0, len(excesses))
+	for _, e := range excesses {
+		msgs = append(msgs, e.msg)
+	}
+	return fmt.Errorf("size budgets exceeded: %s", strings.Join(msgs, "; "))
+}
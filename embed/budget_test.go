@@ -0,0 +1,49 @@
+package embed
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBudgets(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/a.txt":               strings.Repeat("a", 10),
+		"web/node_modules/big.js": strings.Repeat("b", 100),
+	})
+	conf := &Config{
+		Files:        []string{filepath.Join(root, "web")},
+		Prefix:       filepath.Join(root, "web"),
+		MaxFileSize:  50,
+		MaxTotalSize: 100,
+		Warn:         func(string) {},
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []Warning
+	for _, w := range p.Warnings() {
+		if w.Code == WarningFileSize || w.Code == WarningTotalSize {
+			got = append(got, w)
+		}
+	}
+	want := []Warning{
+		{WarningFileSize, "/node_modules/big.js", "/node_modules/big.js: 100 bytes exceed MaxFileSize of 50 bytes"},
+		{WarningTotalSize, "", "110 bytes in 2 files exceed MaxTotalSize of 100 bytes"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("warnings = %+v, want %+v", got, want)
+	}
+
+	conf.StrictSizes = true
+	if _, err := Collect(conf); err == nil || !strings.Contains(err.Error(), "size budgets exceeded: /node_modules/big.js: 100 bytes") {
+		t.Errorf("Collect() error = %v, want size budgets exceeded", err)
+	}
+	conf.MaxFileSize, conf.MaxTotalSize = 100, 110
+	if _, err := Collect(conf); err != nil {
+		t.Errorf("Collect() within budgets error = %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package embed
+
+import (
+	"go/build/constraint"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// checkBuildTags returns an error if expr is not a build constraint
+// expression for Config.BuildTags.
+func checkBuildTags(expr string) error {
+	if expr == "" {
+		return nil
+	}
+	if strings.ContainsAny(expr, "\r\n") {
+		return errors.New("build tags must be on one line")
+	}
+	if _, err := constraint.Parse("//go:build " + expr); err != nil {
+		return errors.Wrapf(err, "build tags %q", expr)
+	}
+	return nil
+}
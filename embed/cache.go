@@ -0,0 +1,117 @@
+package embed
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// gzipCache caches the gzip data of files by content in a directory, or
+// their data compressed in another format. Its methods do nothing on a nil
+// cache.
+type gzipCache struct {
+	dir    string
+	format string
+	level  int
+}
+
+// newGzipCache returns the cache in dir for data compressed in format, or
+// with gzip at level if format is "", or nil if dir is empty or the data is cheap to write
+// anyway.
+func newGzipCache(dir, format string, level int) *gzipCache {
+	if dir == "" || level == gzip.NoCompression {
+		return nil
+	}
+	return &gzipCache{dir: dir, format: format, level: level}
+}
+
+// path returns the file the gzip data of f is cached in. The key covers the
+// Go version, as compress/flate may compress differently in another one.
+func (c *gzipCache) path(f *_escFile) string {
+	key := fmt.Sprintf("gzip %s %d %s", runtime.Version(), c.level, f.SHA256)
+	if c.format != "" {
+		key = fmt.Sprintf("%s %s %s", c.format, runtime.Version(), f.SHA256)
+	}
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+// fill sets the gzip data of the files found in c and returns the others.
+// Zip members with deflate data are never cached.
+func (c *gzipCache) fill(files []*_escFile) []*_escFile {
+	if c == nil {
+		return files
+	}
+	var misses []*_escFile
+	for _, f := range files {
+		gz, err := ioutil.ReadFile(c.path(f))
+		if f.deflated != nil || err != nil || !c.matches(gz, f.Data) {
+			misses = append(misses, f)
+			continue
+		}
+		f.setGzip(gz)
+	}
+	return misses
+}
+
+// store adds the gzip data of files to c. As the cache only saves time,
+// failures to write it are ignored.
+func (c *gzipCache) store(files []*_escFile) {
+	if c == nil {
+		return
+	}
+	for _, f := range files {
+		if f.deflated != nil || f.GzipData == nil {
+			continue
+		}
+		name := c.path(f)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return
+		}
+		// Concurrent runs must never read partly written data.
+		tmp, err := ioutil.TempFile(filepath.Dir(name), ".tmp-")
+		if err != nil {
+			return
+		}
+		_, err = tmp.Write(f.GzipData)
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), name)
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}
+}
+
+// matches reports whether the cached data b holds data. Formats other
+// than gzip have no checksum of the content, so b is decompressed.
+func (c *gzipCache) matches(b, data []byte) bool {
+	if c.format == "" {
+		return gzipMatches(b, data)
+	}
+	content, err := decompressData(c.format, b)
+	return err == nil && bytes.Equal(content, data)
+}
+
+// gzipMatches reports whether the trailer of the gzip data gz holds the
+// checksum and size of data, which catches truncated cache entries.
+func gzipMatches(gz, data []byte) bool {
+	if len(gz) < 18 {
+		return false
+	}
+	trailer := gz[len(gz)-8:]
+	return binary.LittleEndian.Uint32(trailer) == crc32.ChecksumIEEE(data) &&
+		binary.LittleEndian.Uint32(trailer[4:]) == uint32(len(data))
+}
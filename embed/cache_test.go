@@ -0,0 +1,50 @@
+package embed
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGzipCache(t *testing.T) {
+	root, cacheDir := t.TempDir(), t.TempDir()
+	content := strings.Repeat("cached ", 100)
+	writeTree(t, root, map[string]string{"a.txt": content})
+	conf := &Config{Package: "main", Prefix: root, Files: []string{root}, CacheDir: cacheDir}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := newGzipCache(cacheDir, "", gzip.BestCompression).path(p.files[0])
+	if b, err := ioutil.ReadFile(name); err != nil || !bytes.Equal(b, p.files[0].GzipData) {
+		t.Fatalf("cached %d bytes, %v, want the gzip data", len(b), err)
+	}
+
+	// Another compression level encodes the content differently, which only
+	// the cache can have provided.
+	var other bytes.Buffer
+	gw, _ := gzip.NewWriterLevel(&other, gzip.BestSpeed)
+	gw.Write([]byte(content))
+	gw.Close()
+	if err := ioutil.WriteFile(name, other.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = Collect(conf); err != nil || !bytes.Equal(p.files[0].GzipData, other.Bytes()) {
+		t.Errorf("Collect() did not use the cached gzip data: %v", err)
+	}
+
+	if err := ioutil.WriteFile(name, other.Bytes()[:20], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = Collect(conf); err != nil || bytes.Equal(p.files[0].GzipData, other.Bytes()[:20]) {
+		t.Errorf("Collect() used a truncated cache entry: %v", err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(name))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("cache directory holds %d entries, %v, want 1", len(entries), err)
+	}
+}
@@ -0,0 +1,182 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fingerprintHeader starts the line of the header of the output recording
+// the fingerprint of its Plan.
+const fingerprintHeader = "// fingerprint sha256:"
+
+// StaleError is returned by Check for files that differ from those Run
+// would write.
+type StaleError struct {
+	// Diffs summarize how every stale file differs, e.g. "static.go: line 2
+	// differs", with names relative to the directory of the output file.
+	Diffs []string
+}
+
+func (e *StaleError) Error() string {
+	return "generated files are out of date, run esc again: " + strings.Join(e.Diffs, "; ")
+}
+
+// Check generates the output of conf in memory and compares it, together
+// with the test files, shards and go:embed data Run would write, with the
+// files on disk without writing anything. It returns a *StaleError if any of them
+// differs. If the fingerprint in the header of the output file is that of
+// conf, as QuickFingerprint computes it, the files are up to date and Check
+// does not generate them.
+func Check(conf *Config) error {
+	if conf.OutputFile == "" {
+		return errors.New("checking requires an output file")
+	}
+	name, err := outputPath(conf)
+	if err != nil {
+		return err
+	}
+	p, err := collect(conf, nil, false)
+	if err != nil {
+		return err
+	}
+	if fp, err := outputFingerprint(name); err != nil {
+		return err
+	} else if fp == p.Fingerprint() {
+		return nil
+	}
+	if p, err = Collect(conf); err != nil {
+		return err
+	}
+	data, files, err := p.generate()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := writeSource(&buf, data, p.files); err != nil {
+		return err
+	}
+	files[name] = buf.Bytes()
+	dir := filepath.Dir(name)
+
+	var diffs []string
+	var extra []string
+	if conf.UseGoEmbed {
+		dataDir, embedded, err := p.goEmbedData()
+		if err != nil {
+			return err
+		}
+		for name, b := range embedded {
+			files[name] = b
+		}
+		err = filepath.Walk(dataDir, func(name string, fi os.FileInfo, err error) error {
+			if err != nil || fi.IsDir() {
+				return err
+			}
+			if _, ok := embedded[name]; !ok {
+				extra = append(extra, name)
+			}
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, name := range sortedKeys(files) {
+		diff, err := diffFile(name, files[name])
+		if err != nil {
+			return err
+		}
+		if diff != "" {
+			diffs = append(diffs, relName(dir, name)+": "+diff)
+		}
+	}
+	for _, name := range extra {
+		diffs = append(diffs, relName(dir, name)+": not embedded anymore")
+	}
+	shards, err := shardFiles(name)
+	if err != nil {
+		return err
+	}
+	for _, name := range shards {
+		if _, ok := files[name]; !ok {
+			diffs = append(diffs, relName(dir, name)+": not generated anymore")
+		}
+	}
+	if len(diffs) > 0 {
+		return &StaleError{Diffs: diffs}
+	}
+	return nil
+}
+
+// outputFingerprint returns the fingerprint recorded in the header of the
+// output file name, or "" if it has none or does not exist.
+func outputFingerprint(name string) (string, error) {
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+		if strings.HasPrefix(line, fingerprintHeader) {
+			return strings.TrimPrefix(line, fingerprintHeader), nil
+		}
+	}
+	return "", sc.Err()
+}
+
+// diffFile summarizes how the file name differs from want, or returns "" if
+// it does not.
+func diffFile(name string, want []byte) (string, error) {
+	have, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		return "missing", nil
+	} else if err != nil {
+		return "", err
+	}
+	if bytes.Equal(have, want) {
+		return "", nil
+	}
+	haveLines := strings.SplitAfter(string(have), "\n")
+	wantLines := strings.SplitAfter(string(want), "\n")
+	i := 0
+	for i < len(haveLines) && i < len(wantLines) && haveLines[i] == wantLines[i] {
+		i++
+	}
+	line := func(lines []string) string {
+		if i >= len(lines) {
+			return "end of file"
+		}
+		l := strings.TrimSuffix(lines[i], "\n")
+		if len(l) > 60 {
+			l = l[:60] + "…"
+		}
+		return fmt.Sprintf("%q", l)
+	}
+	return fmt.Sprintf("line %d differs, %s instead of %s", i+1, line(haveLines), line(wantLines)), nil
+}
+
+// relName returns name relative to dir if possible.
+func relName(dir, name string) string {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return name
+	}
+	if rel, err := filepath.Rel(dir, abs); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return name
+}
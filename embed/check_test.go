@@ -0,0 +1,116 @@
+package embed
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"assets/a.txt": "a", "assets/b.txt": "b"})
+	out := filepath.Join(root, "pkg")
+	if err := os.Mkdir(out, 0755); err != nil {
+		t.Fatal(err)
+	}
+	conf := &Config{
+		OutputFile:      filepath.Join(out, "static.go"),
+		Package:         "main",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		ModTime:         "0",
+		SkipModuleCheck: true,
+		Conformance:     true,
+		UseGoEmbed:      true,
+	}
+	diffs := func() []string {
+		t.Helper()
+		err := Check(conf)
+		if err == nil {
+			return nil
+		}
+		stale, ok := err.(*StaleError)
+		if !ok {
+			t.Fatal(err)
+		}
+		return stale.Diffs
+	}
+	if got, want := diffs(), []string{
+		"static.go: missing",
+		"static_conformance_test.go: missing",
+		"static_data/a.txt: missing",
+		"static_data/b.txt: missing",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() before Run = %q, want %q", got, want)
+	}
+	if _, err := os.Stat(conf.OutputFile); !os.IsNotExist(err) {
+		t.Errorf("Check() wrote the output file")
+	}
+
+	if _, err := runToFile(conf); err != nil {
+		t.Fatal(err)
+	}
+	if got := diffs(); got != nil {
+		t.Errorf("Check() after Run = %q, want no differences", got)
+	}
+
+	writeTree(t, root, map[string]string{"assets/a.txt": "A"})
+	if err := os.Remove(filepath.Join(root, "assets", "b.txt")); err != nil {
+		t.Fatal(err)
+	}
+	got := diffs()
+	if len(got) != 4 ||
+		!strings.HasPrefix(got[0], "static.go: line 2 differs, \"// fingerprint sha256:") ||
+		!strings.HasPrefix(got[1], "static_conformance_test.go: line ") ||
+		got[2] != `static_data/a.txt: line 1 differs, "a" instead of "A"` ||
+		got[3] != "static_data/b.txt: not embedded anymore" {
+		t.Errorf("Check() after changes = %q", got)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(out, "static_data", "a.txt")); err != nil || string(b) != "a" {
+		t.Errorf("Check() changed the data directory: %q, %v", b, err)
+	}
+}
+
+func TestCheckFingerprint(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"assets/a.txt": strings.Repeat("a", 200), "assets/b.txt": strings.Repeat("b", 200)})
+	conf := &Config{
+		WorkingDir:      root,
+		OutputFile:      "static.go",
+		Package:         "main",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		ModTime:         "0",
+		NoCompression:   true,
+		ShardSize:       100,
+		SkipModuleCheck: true,
+	}
+	if _, err := runToFile(conf); err != nil {
+		t.Fatal(err)
+	}
+	// Check trusts the fingerprint in the header and ignores the rest.
+	out := filepath.Join(root, "static.go")
+	src, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(out, append(src, "// edited\n"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(conf); err != nil {
+		t.Errorf("Check() with the current fingerprint = %v", err)
+	}
+
+	conf.ShardSize = 0
+	err = Check(conf)
+	stale, ok := err.(*StaleError)
+	if !ok {
+		t.Fatalf("Check() after changing the shard size = %v", err)
+	}
+	if got := stale.Diffs[len(stale.Diffs)-1]; got != "static_001.go: not generated anymore" {
+		t.Errorf("Check() after changing the shard size = %q", stale.Diffs)
+	}
+}
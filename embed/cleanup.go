@@ -0,0 +1,105 @@
+package embed
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// renameFile is os.Rename, replaced in tests.
+var renameFile = os.Rename
+
+// cleanups undo the effects of a run on the file system if it fails, so a
+// failed run leaves the working tree as it found it.
+type cleanups []func()
+
+// add registers fn to be called if the run fails. Functions are called in
+// reverse order of registration.
+func (c *cleanups) add(fn func()) {
+	*c = append(*c, fn)
+}
+
+// runUnless calls the registered functions if *err is set or the run
+// panics, in which case the panic continues after them. It must be
+// deferred.
+func (c *cleanups) runUnless(err *error) {
+	r := recover()
+	if r == nil && *err == nil {
+		return
+	}
+	for i := len(*c) - 1; i >= 0; i-- {
+		(*c)[i]()
+	}
+	*c = nil
+	if r != nil {
+		panic(r)
+	}
+}
+
+// writeFile writes data to name like create.
+func (c *cleanups) writeFile(name string, data []byte) error {
+	return c.create(name, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// create writes the content written by write to name through a temporary
+// file in the same directory, so name is never left partially written, and
+// registers restoring the previous content of name, or removing it if it
+// did not exist.
+func (c *cleanups) create(name string, write func(w io.Writer) error) error {
+	mode := os.FileMode(0644)
+	if prev, err := ioutil.ReadFile(name); err == nil {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		mode = fi.Mode().Perm()
+		c.add(func() { ioutil.WriteFile(name, prev, mode) })
+	} else if os.IsNotExist(err) {
+		c.add(func() { os.Remove(name) })
+	} else {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	c.add(func() { os.Remove(tmp.Name()) })
+	bw := bufio.NewWriter(tmp)
+	if err := write(bw); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	return renameFile(tmp.Name(), name)
+}
+
+// remove removes the file name, registering restoring it.
+func (c *cleanups) remove(name string) error {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+	prev, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil {
+		return err
+	}
+	c.add(func() { ioutil.WriteFile(name, prev, fi.Mode().Perm()) })
+	return nil
+}
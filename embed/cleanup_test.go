@@ -0,0 +1,131 @@
+package embed
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// snapshot returns the contents of the files in dir by name.
+func snapshot(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, fi := range fis {
+		b, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[fi.Name()] = string(b)
+	}
+	return files
+}
+
+func TestRunFailureCleanup(t *testing.T) {
+	defer func(saved func(string, string) error) { renameFile = saved }(renameFile)
+	// failExamples fails the rename of the examples file, which is written
+	// after the conformance test.
+	failExamples := func(fail func() error) func(string, string) error {
+		return func(from, to string) error {
+			if strings.HasSuffix(to, "_example_test.go") {
+				return fail()
+			}
+			return os.Rename(from, to)
+		}
+	}
+	tests := []struct {
+		name      string
+		rename    func(string, string) error
+		warn      func(string)
+		wantErr   string
+		wantPanic string
+	}{
+		{
+			name:    "write error",
+			rename:  failExamples(func() error { return errors.New("disk full") }),
+			wantErr: "disk full",
+		},
+		{
+			name:      "panic writing",
+			rename:    failExamples(func() error { panic("rename hook") }),
+			wantPanic: "rename hook",
+		},
+		{
+			name:      "panic in warn hook",
+			rename:    os.Rename,
+			warn:      func(string) { panic("warn hook") },
+			wantPanic: "warn hook",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, map[string]string{
+				"assets/.hidden": "dotfile",
+				"assets/a.txt":   "a",
+			})
+			out := filepath.Join(root, "pkg")
+			writeTree(t, out, map[string]string{
+				"static.go":                  "package main\n",
+				"static_conformance_test.go": "package main\n// old\n",
+			})
+			before := snapshot(t, out)
+
+			renameFile = tt.rename
+			conf := &Config{
+				OutputFile:       filepath.Join(out, "static.go"),
+				Package:          "main",
+				Prefix:           filepath.Join(root, "assets"),
+				Files:            []string{filepath.Join(root, "assets")},
+				SkipModuleCheck:  true,
+				Conformance:      true,
+				GenerateExamples: true,
+				Warn:             tt.warn,
+			}
+			var panicked interface{}
+			err := func() (err error) {
+				defer func() { panicked = recover() }()
+				return Run(conf, ioutil.Discard)
+			}()
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Run() error = %v, want %q", err, tt.wantErr)
+			}
+			if panicked != tt.wantPanic && !(panicked == nil && tt.wantPanic == "") {
+				t.Errorf("Run() panicked with %v, want %q", panicked, tt.wantPanic)
+			}
+			if got := snapshot(t, out); !reflect.DeepEqual(got, before) {
+				t.Errorf("files after failed Run() = %q, want %q", got, before)
+			}
+		})
+	}
+}
+
+func TestRunWritesTestFiles(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"assets/a.txt": "a"})
+	out := filepath.Join(root, "pkg")
+	writeTree(t, out, map[string]string{"static_conformance_test.go": "package main\n// old\n"})
+	conf := &Config{
+		OutputFile:       filepath.Join(out, "static.go"),
+		Package:          "main",
+		Prefix:           filepath.Join(root, "assets"),
+		Files:            []string{filepath.Join(root, "assets")},
+		SkipModuleCheck:  true,
+		Conformance:      true,
+		GenerateExamples: true,
+	}
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	files := snapshot(t, out)
+	if len(files) != 2 || strings.Contains(files["static_conformance_test.go"], "// old") || files["static_example_test.go"] == "" {
+		t.Errorf("files after Run() = %q, want the new conformance test and examples only", files)
+	}
+}
@@ -0,0 +1,127 @@
+package embed
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/andybalholm/brotli"
+	kgzip "github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// Formats for Config.Compression.
+const (
+	// CompressionGzip compresses files with gzip, which the generated code
+	// decodes with the standard library.
+	CompressionGzip = "gzip"
+	// CompressionBrotli compresses files with brotli, which makes text
+	// smaller than gzip does. The generated code imports
+	// github.com/andybalholm/brotli to decode it.
+	CompressionBrotli = "brotli"
+	// CompressionZstd compresses files with zstd, which decompresses faster
+	// than gzip. The generated code imports
+	// github.com/klauspost/compress/zstd to decode it.
+	CompressionZstd = "zstd"
+)
+
+// compressionGzipV1 is the format of files compressed with gzip for
+// FormatV1: gzip data deflated by github.com/klauspost/compress, whose
+// version esc requires, instead of compress/flate, which may compress
+// differently in another Go version.
+const compressionGzipV1 = "gzip v1"
+
+// compressionImports are the packages the generated code decodes the
+// formats other than gzip with.
+var compressionImports = map[string]string{
+	CompressionBrotli: "github.com/andybalholm/brotli",
+	CompressionZstd:   "github.com/klauspost/compress/zstd",
+}
+
+// compressionCodings are the HTTP content codings of the formats, which
+// the generated FSGzipHandler serves them with.
+var compressionCodings = map[string]string{
+	"":                "gzip",
+	CompressionGzip:   "gzip",
+	CompressionBrotli: "br",
+	CompressionZstd:   "zstd",
+}
+
+// checkCompression returns an error if the compression of conf is not a
+// format or cannot be used with the rest of conf.
+func checkCompression(conf *Config) error {
+	switch conf.Compression {
+	case "", CompressionGzip:
+		return nil
+	case CompressionBrotli, CompressionZstd:
+	default:
+		return fmt.Errorf("unknown compression %q, want %s, %s or %s", conf.Compression, CompressionGzip, CompressionBrotli, CompressionZstd)
+	}
+	switch {
+	case conf.NoCompression:
+		return errors.Errorf("%s compression cannot be combined with no compression", conf.Compression)
+	case conf.PrecompressedBrotli:
+		return errors.Errorf("%s compression cannot be combined with precompressed brotli variants", conf.Compression)
+	}
+	return nil
+}
+
+// compressionFormat returns the format files are compressed in with conf,
+// or "" for gzip.
+func compressionFormat(conf *Config) string {
+	if conf.Compression == CompressionGzip {
+		return ""
+	}
+	return conf.Compression
+}
+
+// fileCompression returns the format files are compressed in with conf,
+// which is compressionFormat but compressionGzipV1 for gzip with FormatV1.
+func fileCompression(conf *Config) string {
+	format := compressionFormat(conf)
+	if format == "" && conf.FormatCompat == FormatV1 {
+		return compressionGzipV1
+	}
+	return format
+}
+
+// newCompressor returns a writer compressing to w in format at its best
+// level, or with gzip at gzipLevel if format is "" or compressionGzipV1.
+func newCompressor(w io.Writer, format string, gzipLevel int) (io.WriteCloser, error) {
+	switch format {
+	case CompressionBrotli:
+		return brotli.NewWriterLevel(w, brotli.BestCompression), nil
+	case compressionGzipV1:
+		return kgzip.NewWriterLevel(w, gzipLevel)
+	case CompressionZstd:
+		// A single goroutine keeps the output the same on every machine.
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedBestCompression), zstd.WithEncoderConcurrency(1))
+	}
+	return gzip.NewWriterLevel(w, gzipLevel)
+}
+
+// decompressData returns the content compressed in format as b.
+func decompressData(format string, b []byte) ([]byte, error) {
+	var r io.Reader
+	switch format {
+	case CompressionBrotli:
+		r = brotli.NewReader(bytes.NewReader(b))
+	case CompressionZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		gr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		r = gr
+	}
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,168 @@
+package embed
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/mod/modfile"
+)
+
+// requireModules returns the go.mod and go.sum of the module of generated
+// code requiring the modules of paths at the versions esc requires, so they
+// are found in the module cache.
+func requireModules(t *testing.T, paths ...string) map[string]string {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join("..", "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := modfile.Parse("go.mod", b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sums, err := ioutil.ReadFile(filepath.Join("..", "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod, goSum := "module esctest\n\ngo 1.18\n", ""
+	for _, path := range paths {
+		for _, r := range mod.Require {
+			if r.Mod.Path == path {
+				goMod += "\nrequire " + path + " " + r.Mod.Version + "\n"
+			}
+		}
+		for _, line := range strings.SplitAfter(string(sums), "\n") {
+			if strings.HasPrefix(line, path+" ") {
+				goSum += line
+			}
+		}
+	}
+	return map[string]string{"go.mod": goMod, "go.sum": goSum}
+}
+
+func TestCompression(t *testing.T) {
+	for _, tt := range []struct {
+		format, module, decode string
+	}{
+		{CompressionBrotli, "github.com/andybalholm/brotli", `body, err = ioutil.ReadAll(brotli.NewReader(rec.Body))`},
+		{CompressionZstd, "github.com/klauspost/compress", `var zr *zstd.Decoder
+			if zr, err = zstd.NewReader(rec.Body); err == nil {
+				body, err = ioutil.ReadAll(zr)
+			}`},
+	} {
+		t.Run(tt.format, func(t *testing.T) {
+			testCompression(t, tt.format, tt.module, tt.decode)
+		})
+	}
+
+	for _, c := range []Config{
+		{Compression: "lzma"},
+		{Compression: CompressionBrotli, NoCompression: true},
+		{Compression: CompressionZstd, PrecompressedBrotli: true},
+	} {
+		c.Package = "main"
+		if _, err := Collect(&c); err == nil {
+			t.Errorf("Collect() with %+v must err", c)
+		}
+	}
+}
+
+// testCompression checks files compressed in format, which the generated
+// code decodes with a package of module and a test of it with decode,
+// statements setting body and err to the content read from the response
+// recorder rec.
+func testCompression(t *testing.T, format, module, decode string) {
+	root := t.TempDir()
+	text := strings.Repeat("compressed with "+format+" ", 100)
+	writeTree(t, root, map[string]string{"web/a.txt": text})
+	conf := &Config{
+		Package:     "main",
+		Files:       []string{filepath.Join(root, "web")},
+		Prefix:      filepath.Join(root, "web"),
+		Compression: format,
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := decompressData(format, p.files[0].GzipData); err != nil || string(b) != text {
+		t.Errorf("%s data decompresses to %d bytes, %v", format, len(b), err)
+	}
+
+	coding, imp := compressionCodings[format], compressionImports[format]
+	sources := requireModules(t, module)
+	sources["static_test.go"] = `package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"` + imp + `"
+)
+
+func TestCompression(t *testing.T) {
+	text := strings.Repeat("compressed with ` + format + ` ", 100)
+	if s := FSMustString(false, "/a.txt"); s != text {
+		t.Errorf("FSMustString() = %q", s)
+	}
+	h := FSGzipHandler(FSHandlerOptions{})
+	for _, accept := range []string{"gzip, ` + coding + `", "gzip"} {
+		req := httptest.NewRequest("GET", "/a.txt", nil)
+		req.Header.Set("Accept-Encoding", accept)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		body := rec.Body.Bytes()
+		var err error
+		if coding := rec.Header().Get("Content-Encoding"); coding == "` + coding + `" {
+			` + decode + `
+		} else if coding != "" || accept != "gzip" {
+			t.Errorf("Accept-Encoding %s: Content-Encoding %q", accept, coding)
+		}
+		if err != nil || string(body) != text {
+			t.Errorf("Accept-Encoding %s: body %q, %v", accept, body, err)
+		}
+	}
+}
+`
+	for _, encoding := range []string{EncodingBase64, EncodingString, EncodingPacked} {
+		c := *conf
+		c.Encoding, c.Verify = encoding, true
+		runGenerated(t, &c, sources, "test", ".")
+	}
+
+	var buf bytes.Buffer
+	if err := Run(conf, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "FSGzipByte") {
+		t.Errorf("output with %s compression has FSGzipByte", format)
+	}
+
+	// Extract runs outputs importing a decoder with the requirements of the
+	// module they are in.
+	out := t.TempDir()
+	for name, src := range requireModules(t, module) {
+		writeTree(t, out, map[string]string{name: src})
+	}
+	c := *conf
+	c.OutputFile = filepath.Join(out, "static.go")
+	buf.Reset()
+	if err := Run(&c, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(c.OutputFile, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := Extract(c.OutputFile, dir); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "a.txt")); err != nil || string(b) != text {
+		t.Errorf("extracted a.txt = %d bytes, %v", len(b), err)
+	}
+}
@@ -0,0 +1,96 @@
+package embed
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadConfig reads the configuration file name, e.g. esc.json, into conf.
+// Files ending in .yaml or .yml are read as YAML, files ending in .toml as
+// TOML and all others as JSON. Their keys are the names of Config fields,
+// e.g.
+//
+//	{"Package": "assets", "Prefix": "static", "Files": ["static"]}
+//
+// Fields not in the file keep their value in conf, so conf can hold
+// defaults, and unknown keys are an error. Paths are relative to the working
+// directory, as they are in flags.
+func LoadConfig(name string, conf *Config) error {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	// YAML and TOML are converted to JSON, so that keys match fields like
+	// they do in JSON files.
+	var unmarshal func([]byte, interface{}) error
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	case ".toml":
+		unmarshal = toml.Unmarshal
+	}
+	if unmarshal != nil {
+		var doc map[string]interface{}
+		if err := unmarshal(b, &doc); err != nil {
+			return errors.Wrap(err, name)
+		}
+		if b, err = json.Marshal(doc); err != nil {
+			return errors.Wrap(err, name)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(conf); err != nil {
+		return errors.Wrap(err, name)
+	}
+	return nil
+}
+
+// ParseFlags parses args with fs, whose flags set the fields of conf, after
+// reading the configuration file named by the flag configFlag of fs in args,
+// if any, into conf with LoadConfig, so the file overrides the defaults of
+// the flags and flags given explicitly override the file.
+func ParseFlags(fs *flag.FlagSet, configFlag string, args []string, conf *Config) error {
+	if name := flagValue(fs, configFlag, args); name != "" {
+		if err := LoadConfig(name, conf); err != nil {
+			return err
+		}
+	}
+	return fs.Parse(args)
+}
+
+// flagValue returns the value of the flag name of fs in args, as fs.Parse
+// would set it, without setting any flag, or "" if it is not given.
+func flagValue(fs *flag.FlagSet, name string, args []string) string {
+	var value string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if len(arg) < 2 || arg[0] != '-' || arg == "--" {
+			break
+		}
+		arg = strings.TrimPrefix(arg[1:], "-")
+		arg, v, hasValue := strings.Cut(arg, "=")
+		f := fs.Lookup(arg)
+		if f == nil {
+			break
+		}
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() || hasValue {
+			if arg == name {
+				value = v
+			}
+			continue
+		}
+		if i++; i < len(args) && arg == name {
+			value = args[i]
+		}
+	}
+	return value
+}
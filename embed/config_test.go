@@ -0,0 +1,122 @@
+package embed
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"esc.json": `{
+	"Package": "assets",
+	"Prefix": "static",
+	"IgnoreGlobs": ["**/*.map"],
+	"NoCompression": true,
+	"Files": ["static", "extra"]
+}`,
+		"esc.yaml": `Package: assets
+Prefix: static
+IgnoreGlobs: ["**/*.map"]
+NoCompression: true
+Files:
+  - static
+  - extra
+`,
+		"esc.toml": `Package = "assets"
+Prefix = "static"
+IgnoreGlobs = ["**/*.map"]
+NoCompression = true
+Files = ["static", "extra"]
+`,
+		"typo.json":  `{"Pakage": "assets"}`,
+		"typo.yml":   "Pakage: assets\n",
+		"typo.toml":  `Pakage = "assets"`,
+		"type.json":  `{"Files": "static"}`,
+		"type.yaml":  "Files: static\n",
+		"type.toml":  `Files = "static"`,
+		"empty.json": `{}`,
+		"empty.yaml": "",
+		"empty.toml": "",
+	})
+	want := &Config{
+		Package:       "assets",
+		OutputFile:    "static.go",
+		Prefix:        "static",
+		IgnoreGlobs:   []string{"**/*.map"},
+		NoCompression: true,
+		Files:         []string{"static", "extra"},
+	}
+	for _, name := range []string{"esc.json", "esc.yaml", "esc.toml"} {
+		conf := &Config{Package: "main", OutputFile: "static.go"}
+		if err := LoadConfig(filepath.Join(root, name), conf); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(conf, want) {
+			t.Errorf("LoadConfig(%s) = %+v, want %+v", name, conf, want)
+		}
+	}
+	for _, name := range []string{"empty.json", "empty.yaml", "empty.toml"} {
+		conf := &Config{Package: "main"}
+		if err := LoadConfig(filepath.Join(root, name), conf); err != nil || !reflect.DeepEqual(conf, &Config{Package: "main"}) {
+			t.Errorf("LoadConfig(%s) = %+v, %v, want the defaults", name, conf, err)
+		}
+	}
+
+	for _, name := range []string{"typo.json", "typo.yml", "typo.toml", "type.json", "type.yaml", "type.toml", "missing.json"} {
+		if err := LoadConfig(filepath.Join(root, name), &Config{}); err == nil || !strings.Contains(err.Error(), name) {
+			t.Errorf("LoadConfig(%s) error = %v, want an error naming the file", name, err)
+		}
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"esc.json": `{"Package": "assets", "Prefix": "static", "Minifiers": {"text/css": "csso"}}`,
+	})
+	config := filepath.Join(root, "esc.json")
+	for _, test := range []struct {
+		args []string
+		want Config
+	}{
+		{
+			args: []string{"-config", config, "-minify", "application/json", "static"},
+			want: Config{Package: "assets", Prefix: "static", Minifiers: map[string]string{"text/css": "csso", "application/json": ""}},
+		},
+		{
+			args: []string{"-no-compress", "-pkg=web", "--config=" + config, "-prefix", "-config"},
+			want: Config{Package: "web", Prefix: "-config", NoCompression: true, Minifiers: map[string]string{"text/css": "csso"}},
+		},
+		{
+			args: []string{"-prefix", "x", "static", "-config", config},
+			want: Config{Package: "main", Prefix: "x"},
+		},
+	} {
+		conf := new(Config)
+		fs := flag.NewFlagSet("esc", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		fs.String("config", "", "")
+		fs.StringVar(&conf.Package, "pkg", "main", "")
+		fs.StringVar(&conf.Prefix, "prefix", "", "")
+		fs.BoolVar(&conf.NoCompression, "no-compress", false, "")
+		fs.Func("minify", "", func(s string) error {
+			if conf.Minifiers == nil {
+				conf.Minifiers = make(map[string]string)
+			}
+			mediaType, command, _ := strings.Cut(s, "=")
+			conf.Minifiers[mediaType] = command
+			return nil
+		})
+		if err := ParseFlags(fs, "config", test.args, conf); err != nil {
+			t.Fatalf("ParseFlags(%q) error = %v", test.args, err)
+		}
+		if !reflect.DeepEqual(*conf, test.want) {
+			t.Errorf("ParseFlags(%q) = %+v, want %+v", test.args, *conf, test.want)
+		}
+	}
+}
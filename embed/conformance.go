@@ -0,0 +1,99 @@
+package embed
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var conformanceTmpl = template.Must(template.New("").Parse(conformanceTemplate))
+
+type manifestEntry struct {
+	Name    string
+	IsDir   bool
+	Size    int64
+	ModTime int64
+	SHA256  string
+}
+
+// conformanceFileName returns the name of the conformance test file written
+// next to outputFile.
+func conformanceFileName(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_conformance_test.go"
+}
+
+// conformanceTest returns a test file holding the manifest of p and running
+// the esctest conformance checks against the generated filesystems.
+func (p *Plan) conformanceTest(invocation, functionPrefix string) ([]byte, error) {
+	if p.conf.OutputFile == "" {
+		return nil, errors.New("conformance test requires an output file")
+	}
+	if p.conf.MetadataOnly {
+		return nil, errors.New("conformance test requires embedded file contents")
+	}
+	var manifest []manifestEntry
+	for _, d := range p.dirs {
+		manifest = append(manifest, manifestEntry{Name: d.Name, IsDir: true})
+	}
+	for _, f := range p.files {
+		manifest = append(manifest, manifestEntry{
+			Name:    f.Name,
+			Size:    f.Size,
+			ModTime: f.ModTime,
+			SHA256:  f.SHA256,
+		})
+	}
+	var buf bytes.Buffer
+	if err := conformanceTmpl.Execute(&buf, map[string]interface{}{
+		"Invocation":     invocation,
+		"PackageName":    p.conf.Package,
+		"BuildTags":      p.conf.BuildTags,
+		"FunctionPrefix": functionPrefix,
+		"Manifest":       manifest,
+	}); err != nil {
+		return nil, errors.Wrap(err, "conformance template execution")
+	}
+	data, err := formatFile(p.conf, renameIdents(buf.Bytes(), p.conf.IdentPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "format conformance test")
+	}
+	return data, nil
+}
+
+const conformanceTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"testing"
+
+	"github.com/sbstnsp/esc/embed/esctest"
+)
+
+// _escManifest describes every embedded file and directory.
+var _escManifest = []esctest.Entry{
+{{- range .Manifest}}
+	{Name: {{printf "%q" .Name}}, IsDir: {{.IsDir}}, Size: {{.Size}}, ModTime: {{.ModTime}}, SHA256: "{{.SHA256}}"},
+{{- end}}
+}
+
+func Test_escConformanceStatic(t *testing.T) {
+	esctest.TestFileSystem(t, {{.FunctionPrefix}}FS(false), _escManifest)
+}
+
+func Test_escConformanceLocal(t *testing.T) {
+	// Modification times on disk depend on the checkout.
+	manifest := make([]esctest.Entry, len(_escManifest))
+	for i, e := range _escManifest {
+		e.ModTime = 0
+		manifest[i] = e
+	}
+	esctest.TestFileSystem(t, {{.FunctionPrefix}}FS(true), manifest)
+}
+`
@@ -0,0 +1,40 @@
+package embed
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunConformance(t *testing.T) {
+	if err := Run(&Config{Package: "main", Files: []string{"../testdata/empty"}, Conformance: true}, ioutil.Discard); err == nil {
+		t.Errorf("Run() with Conformance and no OutputFile must err")
+	}
+
+	output := filepath.Join(t.TempDir(), "static.go")
+	conf := &Config{
+		OutputFile:  output,
+		Package:     "main",
+		Private:     true,
+		Files:       []string{"../testdata/empty"},
+		ModTime:     "0",
+		Conformance: true,
+	}
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(output), "static_conformance_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`{Name: "/testdata/empty", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},`,
+		`{Name: "/testdata/empty/1", IsDir: false, Size: 0, ModTime: 0, SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},`,
+		"esctest.TestFileSystem(t, _escFS(false), _escManifest)",
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("conformance test does not contain %s:\n%s", want, b)
+		}
+	}
+}
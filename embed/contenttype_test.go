@@ -0,0 +1,25 @@
+package embed
+
+import "testing"
+
+func Test_contentType(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"/css/main.css", []byte("body{}"), "text/css; charset=utf-8"},
+		{"/IMG/LOGO.PNG", nil, "image/png"},
+		{"/fonts/a.woff2", []byte("wOF2"), "font/woff2"},
+		{"/favicon", []byte("\x89PNG\r\n\x1a\n"), "image/png"},
+		{"/index", []byte("<!DOCTYPE html><p>esc"), "text/html; charset=utf-8"},
+		{"/LICENSE", []byte("MIT"), "text/plain; charset=utf-8"},
+		{"/data.bin", []byte{0, 1, 2}, "application/octet-stream"},
+		{"/data.bin", nil, ""},
+	}
+	for _, tt := range tests {
+		if got := contentType(tt.name, tt.data); got != tt.want {
+			t.Errorf("contentType(%q, %q) = %q, want %q", tt.name, tt.data, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package embed
+
+import (
+	"crypto/sha256"
+	"strconv"
+)
+
+// blob is content embedded once for several files.
+type blob struct {
+	// Name is the name of the constant holding the content.
+	Name string
+	// File is the first file with the content.
+	File *_escFile
+	// Raw is set if the constant holds the content as is, else its gzip
+	// data.
+	Raw bool
+}
+
+// blobs holds the contents of a Plan embedded for more than one file.
+type blobs struct {
+	List []blob
+	// Compressed and Raw map the names of the files sharing their gzip data
+	// or their content to the constants holding it.
+	Compressed, Raw map[string]string
+}
+
+// blobs returns the contents of p embedded for more than one file. The gzip
+// data of files with the same content may differ, e.g. for reused zip
+// members, so it is compared on its own. Shards hold a constant per file,
+// so sharded outputs share nothing, nor do sidecar outputs, whose data file
+// holds the same data once.
+func (p *Plan) blobs() blobs {
+	conf := p.conf
+	b := blobs{Compressed: make(map[string]string), Raw: make(map[string]string)}
+	if conf.ShardSize > 0 || conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.Encoding == EncodingSidecar {
+		return b
+	}
+	type key struct {
+		raw bool
+		sum [sha256.Size]byte
+	}
+	var keys []key
+	owners := make(map[key][]*_escFile)
+	add := func(f *_escFile, raw bool, data []byte) {
+		k := key{raw, sha256.Sum256(data)}
+		if owners[k] == nil {
+			keys = append(keys, k)
+		}
+		owners[k] = append(owners[k], f)
+	}
+	for _, f := range p.files {
+		// Packed files share gzip data by offset, and streamed files have
+		// none until the output is written.
+		if !f.Stored && conf.Encoding != EncodingPacked && f.streamPath == "" {
+			add(f, false, f.GzipData)
+		}
+		if f.Dual || f.Stored {
+			add(f, true, f.Data)
+		}
+	}
+	for _, k := range keys {
+		files := owners[k]
+		if len(files) < 2 {
+			continue
+		}
+		name := "_escBlob" + strconv.Itoa(len(b.List))
+		b.List = append(b.List, blob{Name: name, File: files[0], Raw: k.raw})
+		for _, f := range files {
+			if k.raw {
+				b.Raw[f.Name] = name
+			} else {
+				b.Compressed[f.Name] = name
+			}
+		}
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+package embed
+
+import (
+	"go/build/constraint"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// checkDevTag validates Config.DevTag.
+func checkDevTag(conf *Config) error {
+	if conf.DevTag == "" {
+		return nil
+	}
+	if expr, err := constraint.Parse("//go:build " + conf.DevTag); err != nil {
+		return errors.Wrapf(err, "dev tag %q", conf.DevTag)
+	} else if _, ok := expr.(*constraint.TagExpr); !ok {
+		return errors.Errorf("dev tag %q is not a single build tag", conf.DevTag)
+	}
+	if conf.OutputFile == "" {
+		return errors.New("a dev variant requires an output file")
+	}
+	if conf.MetadataOnly || conf.WrapEmbedVar != "" {
+		return errors.New("a dev variant requires embedded file contents")
+	}
+	return nil
+}
+
+// devFileName returns the name of the dev variant written next to
+// outputFile.
+func devFileName(outputFile, devTag string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_" + devTag + ".go"
+}
+
+// devConstraint returns the build constraint of the dev variant if dev is
+// true, else of the embedded output, for the build constraint buildTags.
+func devConstraint(buildTags, devTag string, dev bool) string {
+	if devTag == "" {
+		return buildTags
+	}
+	if !dev {
+		devTag = "!" + devTag
+	}
+	if buildTags == "" {
+		return devTag
+	}
+	return "(" + buildTags + ") && " + devTag
+}
@@ -0,0 +1,108 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var inventoryTmpl = template.Must(template.New("").Parse(inventoryTemplate))
+
+// Changes of embedded files reported by Diff.
+const (
+	AssetAdded   = "added"
+	AssetRemoved = "removed"
+	AssetChanged = "changed"
+)
+
+// AssetDiff is an embedded file that running conf again adds, removes or
+// changes, see Diff.
+type AssetDiff struct {
+	Name string
+	// Change is AssetAdded, AssetRemoved or AssetChanged.
+	Change string
+}
+
+func (d AssetDiff) String() string {
+	return d.Change + " " + d.Name
+}
+
+// Diff compares the files embedded in the existing output file of conf with
+// those Run would embed now, by size and SHA-256, and returns those that
+// differ sorted by name. It reads the output file as Extract does, with the
+// go command.
+func Diff(conf *Config) ([]AssetDiff, error) {
+	if conf.OutputFile == "" {
+		return nil, errors.New("diffing requires an output file")
+	}
+	output, err := outputPath(conf)
+	if err != nil {
+		return nil, err
+	}
+	p, err := collect(conf, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	out, err := runOutputProgram(output, inventoryTmpl)
+	if err != nil {
+		return nil, err
+	}
+	type asset struct {
+		size int64
+		hash string
+	}
+	embedded := make(map[string]asset)
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		var name string
+		var a asset
+		if _, err := fmt.Sscanf(sc.Text(), "%q %d %s", &name, &a.size, &a.hash); err != nil {
+			return nil, errors.Wrapf(err, "inventory of %s", output)
+		}
+		embedded[name] = a
+	}
+
+	var diffs []AssetDiff
+	for _, f := range p.files {
+		a, ok := embedded[f.Name]
+		delete(embedded, f.Name)
+		switch {
+		case !ok:
+			diffs = append(diffs, AssetDiff{Name: f.Name, Change: AssetAdded})
+		case a.size != f.Size || f.SHA256 != "" && a.hash != "-" && a.hash != f.SHA256:
+			diffs = append(diffs, AssetDiff{Name: f.Name, Change: AssetChanged})
+		}
+	}
+	for name := range embedded {
+		diffs = append(diffs, AssetDiff{Name: name, Change: AssetRemoved})
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+	return diffs, nil
+}
+
+const inventoryTemplate = `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	for _, name := range {{.}}FSNames() {
+		fi, err := {{.}}FSStat(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		hash, err := {{.}}FSHash(name)
+		if err != nil {
+			hash = "-"
+		}
+		fmt.Printf("%q %d %s\n", name, fi.Size(), hash)
+	}
+}
+`
@@ -0,0 +1,93 @@
+package embed
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"assets/a.txt": "a",
+		"assets/b.txt": "b",
+		"assets/c.txt": "c",
+	})
+	conf := &Config{
+		OutputFile:      filepath.Join(root, "static.go"),
+		Package:         "assets",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		SkipModuleCheck: true,
+	}
+	var src strings.Builder
+	if err := Run(conf, &src); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(conf.OutputFile, []byte(src.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if diffs, err := Diff(conf); err != nil || len(diffs) != 0 {
+		t.Fatalf("Diff() of the current output = %v, %v", diffs, err)
+	}
+
+	writeTree(t, root, map[string]string{
+		"assets/a.txt":     "A",
+		"assets/new/d.txt": "d",
+	})
+	if err := os.Remove(filepath.Join(root, "assets/b.txt")); err != nil {
+		t.Fatal(err)
+	}
+	diffs, err := Diff(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []AssetDiff{
+		{Name: "/a.txt", Change: AssetChanged},
+		{Name: "/b.txt", Change: AssetRemoved},
+		{Name: "/new/d.txt", Change: AssetAdded},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("Diff() = %v, want %v", diffs, want)
+	}
+}
+
+func TestDiffGoEmbed(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"assets/a.txt": "a",
+		"assets/b.txt": "b",
+	})
+	conf := &Config{
+		WorkingDir:      root,
+		OutputFile:      "static.go",
+		Package:         "assets",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		UseGoEmbed:      true,
+		SkipModuleCheck: true,
+	}
+	var src strings.Builder
+	if err := Run(conf, &src); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "static.go"), []byte(src.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if diffs, err := Diff(conf); err != nil || len(diffs) != 0 {
+		t.Fatalf("Diff() of the current output = %v, %v", diffs, err)
+	}
+
+	writeTree(t, root, map[string]string{"assets/b.txt": "B"})
+	diffs, err := Diff(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []AssetDiff{{Name: "/b.txt", Change: AssetChanged}}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("Diff() = %v, want %v", diffs, want)
+	}
+}
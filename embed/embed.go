@@ -4,70 +4,417 @@ package embed
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash/crc32"
 	"io"
-	"io/ioutil"
+	"io/fs"
+	"math"
 	"os"
 	"path"
 	"path/filepath"
-	"regexp"
+	"runtime"
 	"sort"
-	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/pkg/errors"
-	"golang.org/x/tools/imports"
 )
 
 // Config contains all information needed to run esc.
 type Config struct {
 	// OutputFile is the file name to write output, else stdout.
 	OutputFile string
+	// WorkingDir is the directory a relative OutputFile is in, used to find
+	// the enclosing module and to resolve imports. It defaults to the current
+	// directory.
+	WorkingDir string
+	// FormatLocalPrefix is passed to goimports as local prefix, grouping
+	// imports starting with it after third-party packages.
+	FormatLocalPrefix string
+	// FormatCompat selects how the output is formatted, FormatDefault or
+	// FormatV1. FormatV1 depends neither on goimports nor on go/format and
+	// compress/flate, so the output is byte-identical whatever Go version
+	// esc is built with.
+	FormatCompat string
 	// Package name for the generated file.
 	Package string
+	// BuildTags, if set, is a build constraint expression, e.g. "enterprise"
+	// or "linux && !oss", written as //go:build line to the output and the
+	// files written next to it, so different asset sets can be generated
+	// into one package for different builds.
+	BuildTags string
+	// DevTag, if set, is a build tag, e.g. "dev", selecting a variant of the
+	// output reading the files from disk, written next to OutputFile with
+	// DevTag as suffix. The output then requires the tag to be unset, and
+	// both ignore the useLocal arguments, so production binaries neither
+	// read from disk nor depend on callers passing false.
+	DevTag string
+	// LocalEnv, if set, is an environment variable, e.g. "ESC_USE_LOCAL",
+	// that forces the generated code into local mode whatever the useLocal
+	// arguments, or DevTag, when set to a true value such as 1, so deployed
+	// builds can be switched to files on disk without rebuilding them.
+	LocalEnv string
 	// Prefix is stripped from filenames.
 	Prefix string
+	// Groups are embedded in addition to Files, each in the directory named
+	// like it, e.g. "/templates", and with functions named like it, e.g.
+	// TemplatesFS for FS of that directory.
+	Groups []Group
+	// Mounts are embedded in addition to Files, each in its own directory
+	// with its own prefix, e.g. ./web/dist in /static and ./docs in /help.
+	Mounts []Mount
 	// Ignore is the regexp for files we should ignore (for example `\.DS_Store`).
 	Ignore string
 	// Include is the regexp for files to include. If provided, only files that
-	// match will be included.
+	// match will be included. Directories are embedded either way, so the
+	// layout of Files is kept even where it holds no included files.
 	Include string
-	// ModTime is the Unix timestamp to override as modification time for all files.
+	// IgnoreGlobs are globs, e.g. "**/*.map", for files and directories to
+	// ignore in addition to Ignore. They match the slash separated paths of
+	// Files and the files under them, where ** matches any number of path
+	// elements, so "assets/**/test/**" skips every test directory.
+	IgnoreGlobs []string
+	// IncludeGlobs are globs for files to include in addition to Include,
+	// with the syntax of IgnoreGlobs.
+	IncludeGlobs []string
+	// IgnoreFile names a file holding additional Ignore regexps, one per line.
+	// Blank lines and lines starting with # are skipped.
+	IgnoreFile string
+	// IncludeFile names a file holding additional Include regexps, one per
+	// line. Blank lines and lines starting with # are skipped.
+	IncludeFile string
+	// FileMode, if not zero, is the mode of all embedded files instead of
+	// their permission bits on disk or in archives.
+	FileMode os.FileMode
+	// ModTime is the Unix timestamp to override as modification time for all
+	// files, or ModTimeGit for the time of the last commit of every file.
+	// The time may also be given in RFC 3339 format, or as ModTimeNow or
+	// ModTimeBuild with an optional offset, e.g. "now-24h". It defaults to
+	// the SOURCE_DATE_EPOCH environment variable.
 	ModTime string
+	// CacheDir, if set, is a directory to cache the gzip data of files in by
+	// content, e.g. esc in os.UserCacheDir, so runs only compress changed
+	// files. It may be shared by concurrent runs.
+	CacheDir string
 	// Private, if true, causes autogenerated functions to be unexported.
 	Private bool
+	// FunctionPrefix, if set, prefixes the names of the generated functions
+	// and types, e.g. "Admin" for AdminFS, instead of "" or, if Private,
+	// "_esc".
+	FunctionPrefix string
+	// IdentPrefix, if set, replaces the _esc prefix of the unexported
+	// identifiers of the generated code, e.g. "_escAdmin" for _escAdminData,
+	// so the output of several runs of esc can share a package.
+	IdentPrefix string
 	// NoCompression, if true, stores the files without compression.
+	// DualStorage avoids decompressing selected files only.
 	NoCompression bool
+	// Compression is the format files are compressed in, CompressionGzip,
+	// the default, CompressionBrotli or CompressionZstd. Formats other than
+	// gzip make the output import a package outside the standard library to
+	// decode them, which the module of the output must require, and the
+	// generated FSGzipHandler serve them with their own content coding
+	// instead of gzip. FSGzipByte is only generated for gzip.
+	Compression string
 	// Invocation, if set, is added to the invocation string in the generated template.
 	Invocation string
+	// InvocationLimit is the length the recorded invocation is truncated to
+	// by eliding trailing Files arguments. Zero means DefaultInvocationLimit
+	// and a negative value disables truncation.
+	InvocationLimit int
+	// ImportPath is the full import path of the generated package. If set, it
+	// is recorded in the package clause and checked against the go.mod
+	// enclosing the output directory.
+	ImportPath string
+	// SkipModuleCheck, if true, disables the ImportPath check against go.mod.
+	SkipModuleCheck bool
+	// Root is the directory local paths given absolutely are recorded
+	// relative to, so the output does not depend on the checkout location.
+	// It defaults to the directory of the go.mod enclosing the output file.
+	Root string
+	// AbsolutePaths, if true, records local paths and the invocation as given
+	// instead of relative to Root.
+	AbsolutePaths bool
+	// Fingerprint, if true, also makes every file available under a name
+	// including its content version, e.g. "/app.0a286891.js", which the
+	// generated FSHandler serves as immutable.
+	Fingerprint bool
+	// MetadataOnly, if true, embeds names, sizes and modification times but
+	// not file contents, which are loaded at runtime by the function
+	// registered with the generated FSSetFetch.
+	MetadataOnly bool
+	// MutableMetadata, if true, adds FSSetModTime and FSResetModTimes to
+	// override the modification times of embedded files at runtime.
+	MutableMetadata bool
+	// DualStorage holds path.Match patterns for the canonical names of files,
+	// e.g. "/fonts/*", embedded uncompressed as well as compressed, so the
+	// generated FSByte returns them without decompressing them, and
+	// FSGzipByte their gzip data. It adds the size of the files to the output.
+	DualStorage []string
+	// Minifiers map media types, e.g. "text/css", to commands minifying the
+	// files of that content type, split into fields without a shell and run
+	// in WorkingDir with a file on standard input, e.g. "minify --type=css".
+	// An empty command selects the built-in minifier, available for
+	// application/json. The files are minified before they are compressed,
+	// so the FS in local mode serves them as on disk.
+	Minifiers map[string]string
+	// ZeroCopy, if true, embeds every file uncompressed as a string constant
+	// that the generated FSString returns and FSByte shares without
+	// copying, for files read often. The slices returned by FSByte are then
+	// backed by read-only memory and must not be modified.
+	ZeroCopy bool
+	// EncryptionKey, if set, is the AES key of 16, 24 or 32 bytes the gzip
+	// data of the files is encrypted with, so the output does not reveal
+	// them without it. Every file is then embedded compressed. The generated
+	// code decrypts files when they are first read, with the key set with
+	// FSSetKey or else given hex encoded in the environment variable
+	// EncryptionKeyEnv.
+	EncryptionKey []byte
+	// EncryptionKeyEnv is the environment variable the generated code reads
+	// the EncryptionKey from, if not set with FSSetKey.
+	EncryptionKeyEnv string
+	// Verify, if true, makes the generated code check the content of every
+	// file against its SHA-256 hash when it is decompressed, so corrupted
+	// data fails every read of the file with an error naming it instead of
+	// surfacing as a gzip error or wrong content.
+	Verify bool
+	// PrecompressedBrotli, if true, embeds a file named like another one with
+	// a .br extension, e.g. "app.js.br" made by the brotli tool, as the
+	// brotli compressed variant of that file instead of as a file. The
+	// generated FSGzipHandler serves it to clients accepting brotli. esc
+	// cannot check that the variant is up to date, as the standard library
+	// has no brotli decoder.
+	PrecompressedBrotli bool
+	// Interface, if true, also generates the FSAssets interface with
+	// FSInstance implementing it with the embedded assets and NewFSFake
+	// implementing it in memory, so code using the assets can be tested
+	// with fakes.
+	Interface bool
+	// ParseTemplates, if true, also generates ParseTemplates parsing
+	// embedded files as html/template templates.
+	ParseTemplates bool
+	// PathConstants, if true, also generates a constant holding the
+	// canonical name of every embedded file, e.g. FileIndexHTML for
+	// "/index.html", so renaming a file breaks the build of code using it.
+	PathConstants bool
+	// WrapEmbedVar, if set, names an embed.FS variable in the generated
+	// package holding the files. The output then embeds no file contents but
+	// reads them from the variable, with paths relative to the output
+	// directory, and has a FSSelfCheck function reporting files that differ
+	// from those esc was run on.
+	WrapEmbedVar string
+	// UseGoEmbed, if true, writes the file contents to a data directory next
+	// to OutputFile, named like it with a _data suffix, e.g. "static_data",
+	// and embeds them with a go:embed directive instead of in the output.
+	// The generated functions are the same as without it. Files in the data
+	// directory that are not embedded anymore are removed.
+	UseGoEmbed bool
+	// ShardSize, if positive, moves the embedded data of files out of
+	// OutputFile to files next to it named like it with a _000.go, _001.go,
+	// … suffix, each holding data of about ShardSize bytes, so no generated
+	// file is too large for editors and the compiler. Shards left over from
+	// earlier runs are removed.
+	ShardSize int64
+	// Conformance, if true, also writes a test file next to OutputFile with
+	// the manifest of the embedded assets and esctest conformance tests.
+	Conformance bool
+	// GenerateExamples, if true, also writes a test file next to OutputFile
+	// with runnable examples of the generated functions.
+	GenerateExamples bool
+	// JSONManifest, if true, also writes the names, sizes, SHA-256 hashes
+	// and modification times of the embedded files as JSON next to
+	// OutputFile, for tools comparing the assets of versions.
+	JSONManifest bool
+	// Afero, if true, also writes AferoFs, adapting the assets to afero.Fs of
+	// github.com/spf13/afero v1.9 or later, to a file next to OutputFile, so
+	// the output itself keeps depending on the standard library only.
+	Afero bool
+	// Billy, if true, also writes BillyFS, copying the assets to a new
+	// in-memory billy.Filesystem of github.com/go-git/go-billy/v5, to a file
+	// next to OutputFile.
+	Billy bool
+	// Encoding selects how the gzip data of files is written in the output:
+	// EncodingBase64, the default if empty, EncodingString, EncodingPacked
+	// or EncodingSidecar.
+	Encoding string
+	// Symlinks selects what happens to symlinks found in embedded
+	// directories: SymlinksFollow, the default if empty, SymlinksSkip or
+	// SymlinksError. Symlinks in Files are always followed.
+	Symlinks string
+	// SkipHidden, if true, skips files and directories found in embedded
+	// directories whose name starts with a dot, such as .git or .DS_Store.
+	// Those in Files are embedded.
+	SkipHidden bool
+	// MaxDepth, if positive, is how many levels the walk descends beneath
+	// every directory in Files: 1 embeds the files in it only, 2 also those
+	// in its subdirectories. Directories deeper down are skipped.
+	MaxDepth int
+	// GitIgnore, if true, skips files and directories found in embedded
+	// directories that match the .gitignore files of the walked directories,
+	// following the rules of git. Those in Files are embedded.
+	GitIgnore bool
+	// LookupMode selects how the generated code looks up embedded names:
+	// LookupMap, the default if empty, LookupBinarySearch or LookupCompact.
+	LookupMode string
+	// CaseInsensitive, if true, also finds embedded files and directories
+	// under names differing in case, e.g. "/Logo.PNG" for "/logo.png", as on
+	// the file systems of Windows and macOS. The output then holds an index
+	// of the lower case names, and Run fails if two names differ in case
+	// only.
+	CaseInsensitive bool
+	// Normalization, if set, is the Unicode normalization form of the
+	// embedded names, NormalizationNFC, which the generated lookup also
+	// normalizes names to, so files named on macOS are found under the
+	// names written elsewhere. It fails if two names normalize to the same.
+	Normalization string
+	// StrictKeys, if true, makes keys of fields naming embedded files, such
+	// as ExpandArchives, that match nothing an error instead of a warning.
+	StrictKeys bool
+	// MaxFileSize and MaxTotalSize, if positive, are budgets in bytes for
+	// the size of every embedded file and of all of them, before
+	// compression, which catch directories such as node_modules embedded
+	// by accident. Exceeding them is a warning unless StrictSizes is set.
+	MaxFileSize  int64
+	MaxTotalSize int64
+	// StrictSizes, if true, makes exceeding MaxFileSize or MaxTotalSize an
+	// error instead of a warning.
+	StrictSizes bool
+	// Warn, if set, is called with the message of every Warning. Otherwise
+	// warnings are only recorded, see RunResult.Warnings.
+	Warn func(msg string)
 
-	// Files is the list of files or directories to embed.
+	// Files is the list of files or directories to embed. Directories are
+	// embedded with all directories under them, also empty ones, but for
+	// the paths matched by a .escignore file at their root, which holds
+	// patterns in the syntax of .gitignore and is not embedded. Entries
+	// starting with https:// are downloaded and embedded like InlineFiles,
+	// with the content pinned by the sha256 parameter of the fragment and
+	// the name given by its name parameter, which defaults to the base name
+	// of the URL.
 	Files []string
+	// ExpandArchives holds path.Match patterns for the canonical names of zip
+	// and tar archives, e.g. "/vendor/*.zip", whose members are embedded
+	// instead of the archive. Members are mounted in the directory of the
+	// archive and served from the embedded data also in local mode. Zip
+	// members compressed with deflate keep their compressed data, unless
+	// NoCompression is set. Other archives are embedded as files.
+	ExpandArchives []string
+	// KeepArchiveName, if true, mounts the members of an expanded archive in
+	// a directory named like the archive without its extension, e.g.
+	// "/vendor/lib" for "/vendor/lib.zip".
+	KeepArchiveName bool
+	// InlineFiles maps canonical names, e.g. "/build/stamp.txt", to contents
+	// embedded exactly as given in addition to Files. They have no local
+	// path, so they are served from the embedded data also in local mode,
+	// and are listed in a directory only if it is walked from Files.
+	InlineFiles map[string][]byte
 }
 
-var modTime *int64
-
-var tmpl = template.Must(template.New("").Parse(fileTemplate))
+var tmpl = template.Must(template.New("").Funcs(literalFuncs).Parse(fileTemplate))
 
 type templateParams struct {
-	Invocation     string
-	PackageName    string
-	FunctionPrefix string
-	Files          []*_escFile
-	Dirs           []*_escDir
+	Invocation      string
+	PackageName     string
+	ImportPath      string
+	FunctionPrefix  string
+	Files           []*_escFile
+	Dirs            []*_escDir
+	Tree            *Node
+	MetadataOnly    bool
+	WrapEmbedVar    string
+	GoEmbedDir      string
+	MutableMetadata bool
+	Interface       bool
+	ParseTemplates  bool
+	PathConstants   []pathConstant
+	Folded          []foldedName
+	NFC             *nfcTables
+	Encrypted       bool
+	Verify          bool
+	KeyEnv          string
+	ZeroCopy        bool
+	Raw             bool
+	Brotli          bool
+	// Compression is the format of the compressed data if it is not gzip,
+	// CompressionImport the package decoding it, and Coding its HTTP
+	// content coding.
+	Compression       string
+	CompressionImport string
+	Coding            string
+	BuildTags         string
+	StringEncoding    bool
+	Sharded           bool
+	PatternFiles      []patternFile
+	Fingerprint       string
+	BinarySearch      bool
+	EntryIndex        map[string]int
+	Compact           *compactLayout
+	Groups            []groupParams
+	Blobs             blobs
+	Packed            *packedLayout
+	// SidecarFile is the name of the data file embedded as _escPacked with
+	// EncodingSidecar.
+	SidecarFile string
+	// DevVariant renders the variant reading files from disk for
+	// Config.DevTag, and ForceLocal, if set, replaces the useLocal
+	// arguments.
+	DevVariant bool
+	ForceLocal string
+	// LocalEnv is Config.LocalEnv, read by _escLocalEnv.
+	LocalEnv string
 }
 
 type _escFile struct {
-	Name       string
-	BaseName   string
-	Data       []byte
-	Local      string
-	ModTime    int64
-	Compressed string
+	Name     string
+	BaseName string
+	Data     []byte
+	Size     int64
+	Local    string
+	ModTime  int64
+	Mode     os.FileMode
+	// CompressedSize is the size of the gzip data before base64 encoding.
+	CompressedSize int64
+	// GzipData is the gzip data, written base64 encoded by writeBase64
+	// unless quoted.
+	GzipData    []byte
+	SHA256      string
+	Version     string
+	Fingerprint string
+	EmbedPath   string
+	// Archive is the local path of the archive the file was expanded from.
+	Archive string
+	// Dual is set if Data is embedded uncompressed as well, see
+	// Config.DualStorage.
+	Dual bool
+	// uncommitted is set if ModTime is the modification time on disk
+	// instead of the time of the last commit, see ModTimeGit.
+	uncommitted bool
+	// Stored is set if Data is embedded uncompressed only, as compressing it
+	// would not make the output smaller.
+	Stored bool
+	// Brotli is the brotli compressed variant of Data, see
+	// Config.PrecompressedBrotli.
+	Brotli []byte
+	// ContentType is the MIME type by extension or content, if known.
+	ContentType string
 
 	fileinfo os.FileInfo
+	// compression is the format of GzipData, see Config.Compression.
+	compression string
+	// streamPath is the local path of a file too large to hold in memory,
+	// whose Data and GzipData are nil, and head its first bytes, see
+	// streamSize.
+	streamPath string
+	head       []byte
+	// deflated is the raw deflate data of Data as found in a zip archive,
+	// reused as the gzip data of the file, and crc32 the checksum of Data.
+	deflated []byte
+	crc32    uint32
 }
 
 type _escDir struct {
@@ -75,63 +422,254 @@ type _escDir struct {
 	BaseName       string
 	Local          string
 	ChildFileNames []string
+	// Archive is the local path of the archive the directory was expanded
+	// from.
+	Archive string
 }
 
-// Run executes a Config.
+// Run executes a Config. If it fails or panics, the test files and the
+// go:embed data it wrote next to the output file are restored to their
+// previous content or removed.
 func Run(conf *Config, out io.Writer) error {
+	_, err := RunWithResult(conf, out)
+	return err
+}
+
+// Collect walks the files and directories named by conf and prepares them
+// for embedding without producing any output.
+func Collect(conf *Config) (*Plan, error) {
+	return collect(conf, nil, true)
+}
+
+// QuickFingerprint returns the fingerprint of the Plan that Collect would
+// return for conf, see Plan.Fingerprint. It reads every file but skips
+// compression, which makes it cheap enough for pre-commit hooks.
+func QuickFingerprint(conf *Config) (string, error) {
+	p, err := collect(conf, nil, false)
+	if err != nil {
+		return "", err
+	}
+	return p.Fingerprint(), nil
+}
+
+// collect implements Collect, adding the files of fsys, if not nil, as
+// RunFS does. If compress is false, the files are not compressed and the
+// Plan must not be rendered.
+func collect(conf *Config, fsys fs.FS, compress bool) (*Plan, error) {
 	var err error
-	if conf.ModTime != "" {
-		i, err := strconv.ParseInt(conf.ModTime, 10, 64)
-		if err != nil {
-			return fmt.Errorf("modtime must be an integer: %v", err)
+	modTimes, err := newModTimes(conf.ModTime, conf.WorkingDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkImportPath(conf); err != nil {
+		return nil, err
+	}
+	if err := checkLookupMode(conf.LookupMode); err != nil {
+		return nil, err
+	}
+	if err := checkNormalization(conf.Normalization); err != nil {
+		return nil, err
+	}
+	if err := checkEncoding(conf); err != nil {
+		return nil, err
+	}
+	if err := checkCompression(conf); err != nil {
+		return nil, err
+	}
+	if err := checkSymlinks(conf.Symlinks); err != nil {
+		return nil, err
+	}
+	if err := checkShards(conf); err != nil {
+		return nil, err
+	}
+	if err := checkBrotli(conf); err != nil {
+		return nil, err
+	}
+	if err := checkMinifiers(conf); err != nil {
+		return nil, err
+	}
+	if err := checkZeroCopy(conf); err != nil {
+		return nil, err
+	}
+	if err := checkEncryption(conf); err != nil {
+		return nil, err
+	}
+	if conf.Verify && (conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed) {
+		return nil, errors.New("integrity verification requires file contents embedded in the output")
+	}
+	if err := checkBuildTags(conf.BuildTags); err != nil {
+		return nil, err
+	}
+	if err := checkDevTag(conf); err != nil {
+		return nil, err
+	}
+	if err := checkPrefixes(conf); err != nil {
+		return nil, err
+	}
+	if err := checkGroups(conf.Groups); err != nil {
+		return nil, err
+	}
+	if err := checkMounts(conf.Mounts); err != nil {
+		return nil, err
+	}
+	if conf.UseGoEmbed {
+		if err := checkGoEmbed(conf); err != nil {
+			return nil, err
+		}
+		// The contents are written to the data directory.
+		compress = false
+	} else if len(conf.DualStorage) > 0 && (conf.MetadataOnly || conf.WrapEmbedVar != "") {
+		return nil, errors.New("dual storage requires embedded file contents")
+	}
+	if conf.ZeroCopy {
+		// Every file is stored uncompressed.
+		compress = false
+	}
+	root, err := projectRoot(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "project root")
+	}
+
+	inline, err := fetchRemote(conf.Files)
+	if err != nil {
+		return nil, err
+	}
+	for name, b := range conf.InlineFiles {
+		if _, ok := inline[path.Join("/", name)]; ok {
+			return nil, fmt.Errorf("%s: duplicate Name of inline file", path.Join("/", name))
 		}
-		modTime = &i
+		inline[name] = b
 	}
 
 	alreadyPrepared := make(map[string]bool, 10)
 	escFiles := make([]*_escFile, 0, 10)
-	prefix := filepath.ToSlash(conf.Prefix)
-	var ignoreRegexp *regexp.Regexp
-	if conf.Ignore != "" {
-		ignoreRegexp, err = regexp.Compile(conf.Ignore)
-		if err != nil {
-			return err
+	var embedDir string
+	if conf.WrapEmbedVar != "" {
+		if conf.MetadataOnly {
+			return nil, errors.New("an embed.FS variable cannot be wrapped with metadata only")
+		}
+		if len(inline) > 0 {
+			return nil, errors.New("inline and remote files cannot be read from an embed.FS variable")
 		}
+		if embedDir, err = outputDir(conf); err != nil {
+			return nil, err
+		}
+		if embedDir, err = filepath.Abs(embedDir); err != nil {
+			return nil, err
+		}
+		// The contents are read from the variable at runtime.
+		compress = false
+	}
+	namer, err := newFileNamer(conf.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	type input struct {
+		base  string
+		namer *fileNamer
 	}
-	var includeRegexp *regexp.Regexp
-	if conf.Include != "" {
-		includeRegexp, err = regexp.Compile(conf.Include)
+	var inputs []input
+	for _, base := range conf.Files {
+		if !isRemote(base) {
+			inputs = append(inputs, input{base, namer})
+		}
+	}
+	groupNamers := make([]*fileNamer, len(conf.Groups))
+	for i, g := range conf.Groups {
+		if groupNamers[i], err = newFileNamer(g.Prefix); err != nil {
+			return nil, err
+		}
+		groupNamers[i].mount = "/" + g.Name
+		for _, base := range g.Files {
+			inputs = append(inputs, input{base, groupNamers[i]})
+		}
+	}
+	for _, m := range conf.Mounts {
+		fi, err := os.Stat(m.Src)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		prefix := m.Src
+		if !fi.IsDir() {
+			prefix = filepath.Dir(m.Src)
+		}
+		mn, err := newFileNamer(prefix)
+		if err != nil {
+			return nil, err
 		}
+		mn.mount = m.Dir
+		inputs = append(inputs, input{m.Src, mn})
+	}
+	var patternFiles []patternFile
+	ignore, pf, err := compilePatterns(conf.Ignore, conf.IgnoreGlobs, conf.IgnoreFile)
+	if err != nil {
+		return nil, err
+	}
+	if pf != nil {
+		pf.Kind = "ignore-file"
+		patternFiles = append(patternFiles, *pf)
+	}
+	include, pf, err := compilePatterns(conf.Include, conf.IncludeGlobs, conf.IncludeFile)
+	if err != nil {
+		return nil, err
+	}
+	if pf != nil {
+		pf.Kind = "include-file"
+		patternFiles = append(patternFiles, *pf)
+	}
+	for i := range patternFiles {
+		patternFiles[i].Path = rootRelative(root, patternFiles[i].Path)
 	}
 	gzipLevel := gzip.BestCompression
 	if conf.NoCompression {
 		gzipLevel = gzip.NoCompression
 	}
+	stream := streams(conf)
+	var gitignores gitignore
 	directories := make([]*_escDir, 0, 10)
-	for _, base := range conf.Files {
-		files := []string{base}
+	var archives []pendingArchive
+	chains := make(dirChains)
+	// pending is a file to walk at depth levels beneath its input.
+	type pending struct {
+		name  string
+		depth int
+	}
+	for _, in := range inputs {
+		namer := in.namer
+		files := []pending{{in.base, 0}}
 		for len(files) > 0 {
-			fname := files[0]
+			fname, depth := files[0].name, files[0].depth
 			files = files[1:]
-			if ignoreRegexp != nil && ignoreRegexp.MatchString(fname) {
+			if ignore.MatchString(fname) || conf.UseGoEmbed && isGoEmbedData(conf, fname) {
 				continue
 			}
 			f, err := os.Open(fname)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			fi, err := f.Stat()
 			if err != nil {
-				return err
+				return nil, err
 			}
-			fpath := filepath.ToSlash(fname)
-			n := canonicFileName(fname, prefix)
+			fpath := rootRelative(root, fname)
+			n := namer.name(fname)
 			if fi.IsDir() {
+				if err := chains.enter(fname); err != nil {
+					return nil, err
+				}
 				fis, err := f.Readdir(0)
 				if err != nil {
-					return err
+					return nil, err
+				}
+				if depth == 0 {
+					if err := gitignores.load(fname, escIgnoreFile, "escignore"); err != nil {
+						return nil, err
+					}
+				}
+				if conf.GitIgnore {
+					if err := gitignores.load(fname, ".gitignore", "gitignore"); err != nil {
+						return nil, err
+					}
 				}
 				dir := &_escDir{
 					Name:           n,
@@ -140,38 +678,78 @@ func Run(conf *Config, out io.Writer) error {
 					ChildFileNames: make([]string, 0, len(fis)),
 				}
 				for _, fi := range fis {
+					if conf.SkipHidden && strings.HasPrefix(fi.Name(), ".") {
+						continue
+					}
 					childFName := filepath.Join(fname, fi.Name())
-					files = append(files, childFName)
-					if ignoreRegexp != nil && ignoreRegexp.MatchString(childFName) {
+					if fi.Mode()&os.ModeSymlink != 0 {
+						if conf.Symlinks == SymlinksError {
+							return nil, fmt.Errorf("%s: symlinks are not allowed", childFName)
+						} else if conf.Symlinks == SymlinksSkip {
+							continue
+						}
+					}
+					isDir := fi.IsDir()
+					if fi.Mode()&os.ModeSymlink != 0 {
+						target, err := os.Stat(childFName)
+						isDir = err == nil && target.IsDir()
+					}
+					if depth == 0 && fi.Name() == escIgnoreFile || gitignores.ignored(childFName, isDir) {
+						continue
+					}
+					if isDir && conf.MaxDepth > 0 && depth+1 >= conf.MaxDepth {
+						continue
+					}
+					files = append(files, pending{childFName, depth + 1})
+					if ignore.MatchString(childFName) || conf.UseGoEmbed && isGoEmbedData(conf, childFName) {
 						continue
 					}
-					if includeRegexp == nil || includeRegexp.MatchString(childFName) {
-						dir.ChildFileNames = append(dir.ChildFileNames, canonicFileName(filepath.Join(fname, fi.Name()), prefix))
+					if isDir || len(include) == 0 || include.MatchString(childFName) {
+						dir.ChildFileNames = append(dir.ChildFileNames, namer.name(childFName))
 					}
 				}
 				sort.Strings(dir.ChildFileNames)
 				directories = append(directories, dir)
-			} else if includeRegexp == nil || includeRegexp.MatchString(fname) {
-				b, err := ioutil.ReadAll(f)
+			} else if len(include) == 0 || include.MatchString(fname) {
+				expand, err := matchKeys(configKeys{"ExpandArchives", conf.ExpandArchives}, n)
 				if err != nil {
-					return errors.Wrap(err, "readAll return err")
+					return nil, err
+				}
+				if expand {
+					archives = append(archives, pendingArchive{Name: n, File: fname, Local: fpath})
+					f.Close()
+					continue
 				}
 				if alreadyPrepared[n] {
-					return fmt.Errorf("%s, %s: duplicate Name after prefix removal", n, fpath)
+					return nil, fmt.Errorf("%s, %s: duplicate Name after prefix removal", n, fpath)
 				}
 				escFile := &_escFile{
 					Name:     n,
 					BaseName: path.Base(n),
-					Data:     b,
+					Size:     fi.Size(),
 					Local:    fpath,
 					fileinfo: fi,
 					ModTime:  fi.ModTime().Unix(),
+					Mode:     fi.Mode().Perm(),
 				}
-				if modTime != nil {
-					escFile.ModTime = *modTime
+				if escFile.uncommitted, err = modTimes.set(escFile, fname); err != nil {
+					return nil, err
 				}
-				if err := escFile.fillCompressed(gzipLevel); err != nil {
-					return err
+				if embedDir != "" {
+					if escFile.EmbedPath, err = embedPath(embedDir, fname); err != nil {
+						return nil, err
+					}
+				}
+				if stream && fi.Size() >= streamSize {
+					if err := escFile.setStreamed(fname, f, conf.Fingerprint); err != nil {
+						return nil, errors.Wrap(err, fpath)
+					}
+				} else if !conf.MetadataOnly {
+					b, err := readAll(f, fi.Size())
+					if err != nil {
+						return nil, errors.Wrap(err, "readAll return err")
+					}
+					escFile.setData(b, conf.Fingerprint)
 				}
 				escFiles = append(escFiles, escFile)
 				alreadyPrepared[n] = true
@@ -180,302 +758,2721 @@ func Run(conf *Config, out io.Writer) error {
 		}
 	}
 
-	sort.Slice(escFiles, func(i, j int) bool { return strings.Compare(escFiles[i].Name, escFiles[j].Name) == -1 })
-	sort.Slice(directories, func(i, j int) bool { return strings.Compare(directories[i].Name, directories[j].Name) == -1 })
-
-	functionPrefix := ""
-	if conf.Private {
-		functionPrefix = "_esc"
+	for _, pf := range gitignores.files {
+		pf.Path = rootRelative(root, pf.Path)
+		patternFiles = append(patternFiles, pf)
+	}
+	if fsys != nil {
+		archives = append(archives, pendingArchive{Name: "/", Local: fsLocal, FS: fsys})
 	}
 
-	buf := bytes.NewBuffer(nil)
-	tmpl.Execute(buf, templateParams{
-		Invocation:     conf.Invocation,
-		PackageName:    conf.Package,
-		FunctionPrefix: functionPrefix,
-		Files:          escFiles,
-		Dirs:           directories,
-	})
-
-	fakeOutFileName := "static.go"
-	if conf.OutputFile != "" {
-		fakeOutFileName = conf.OutputFile
+	dirs := make(map[string]*_escDir, len(directories))
+	for _, d := range directories {
+		dirs[d.Name] = d
+	}
+	for _, a := range archives {
+		files, archiveDirs, err := expandArchive(a, conf, modTimes)
+		if err != nil {
+			return nil, err
+		}
+		if parent, ok := dirs[path.Dir(a.Name)]; ok {
+			children := parent.ChildFileNames[:0]
+			for _, c := range parent.ChildFileNames {
+				if c != a.Name {
+					children = append(children, c)
+				}
+			}
+			parent.ChildFileNames = children
+		}
+		var added []string
+		for _, d := range archiveDirs {
+			if _, exists := dirs[d.Name]; exists || alreadyPrepared[d.Name] {
+				return nil, fmt.Errorf("%s: expanded from %s collides with an embedded file or directory", d.Name, a.Local)
+			}
+			added = append(added, d.Name)
+		}
+		for _, f := range files {
+			if _, exists := dirs[f.Name]; exists || alreadyPrepared[f.Name] {
+				return nil, fmt.Errorf("%s: expanded from %s collides with an embedded file or directory", f.Name, a.Local)
+			}
+			if !conf.MetadataOnly {
+				f.setData(f.Data, conf.Fingerprint)
+			} else {
+				f.Data = nil
+			}
+			escFiles = append(escFiles, f)
+			alreadyPrepared[f.Name] = true
+			added = append(added, f.Name)
+		}
+		for _, n := range added {
+			if parent, ok := dirs[path.Dir(n)]; ok {
+				parent.ChildFileNames = append(parent.ChildFileNames, n)
+				sort.Strings(parent.ChildFileNames)
+			}
+		}
+		for _, d := range archiveDirs {
+			dirs[d.Name] = d
+			directories = append(directories, d)
+		}
 	}
 
-	data, err := imports.Process(fakeOutFileName, buf.Bytes(), nil)
-	if err != nil {
-		return errors.Wrap(err, "imports.Process return error")
+	if len(inline) > 0 {
+		names := make([]string, 0, len(inline))
+		for name := range inline {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			n := path.Join("/", name)
+			if alreadyPrepared[n] {
+				return nil, fmt.Errorf("%s: duplicate Name of inline file", n)
+			}
+			if _, isDir := dirs[n]; isDir {
+				return nil, fmt.Errorf("%s: inline file Name is a directory", n)
+			}
+			b := inline[name]
+			escFile := &_escFile{
+				Name:     n,
+				BaseName: path.Base(n),
+				Size:     int64(len(b)),
+			}
+			if modTimes.fixed != nil {
+				escFile.ModTime = *modTimes.fixed
+			}
+			if !conf.MetadataOnly {
+				escFile.setData(b, conf.Fingerprint)
+			}
+			escFiles = append(escFiles, escFile)
+			alreadyPrepared[n] = true
+			if d, ok := dirs[path.Dir(n)]; ok {
+				d.ChildFileNames = append(d.ChildFileNames, n)
+				sort.Strings(d.ChildFileNames)
+			}
+		}
 	}
 
-	fmt.Fprint(out, string(data))
+	if conf.Normalization != "" {
+		if err := normalizeNames(escFiles, directories, conf.Normalization); err != nil {
+			return nil, err
+		}
+		dirs = make(map[string]*_escDir, len(directories))
+		for _, d := range directories {
+			dirs[d.Name] = d
+		}
+	}
+	sort.Slice(escFiles, func(i, j int) bool { return strings.Compare(escFiles[i].Name, escFiles[j].Name) == -1 })
+	if conf.PrecompressedBrotli {
+		escFiles = attachBrotli(escFiles, dirs)
+	}
+	for _, f := range escFiles {
+		if f.streamPath != "" {
+			f.ContentType = contentType(f.Name, f.head)
+		} else {
+			f.ContentType = contentType(f.Name, f.Data)
+		}
+		f.compression = fileCompression(conf)
+	}
+	if err := minify(escFiles, conf); err != nil {
+		return nil, err
+	}
+	if compress && !conf.MetadataOnly {
+		// Streamed files are compressed while the output is written.
+		var inMemory []*_escFile
+		for _, f := range escFiles {
+			if f.streamPath == "" {
+				inMemory = append(inMemory, f)
+			}
+		}
+		cache := newGzipCache(conf.CacheDir, fileCompression(conf), gzipLevel)
+		misses := cache.fill(inMemory)
+		if err := compressFiles(misses, gzipLevel); err != nil {
+			return nil, err
+		}
+		cache.store(misses)
+	}
+	for _, f := range escFiles {
+		if conf.FileMode != 0 {
+			f.Mode = conf.FileMode
+		}
+		if f.Dual, err = matchKeys(configKeys{"DualStorage", conf.DualStorage}, f.Name); err != nil {
+			return nil, err
+		}
+		// Dual files keep their gzip data for FSGzipByte, and encrypted
+		// files as that is what is encrypted.
+		if compress && !conf.NoCompression && !f.Dual && len(conf.EncryptionKey) == 0 {
+			f.storeIfSmaller(quoted(conf.Encoding))
+		}
+		if conf.ZeroCopy && !conf.MetadataOnly {
+			f.Stored = true
+		}
+	}
+	if compress && len(conf.EncryptionKey) > 0 {
+		if err := encryptFiles(escFiles, conf.EncryptionKey); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(directories, func(i, j int) bool { return strings.Compare(directories[i].Name, directories[j].Name) == -1 })
 
-	return nil
+	p := &Plan{
+		conf:         conf,
+		root:         root,
+		files:        escFiles,
+		dirs:         directories,
+		patternFiles: patternFiles,
+	}
+	if conf.UseGoEmbed {
+		if err := p.setGoEmbedPaths(); err != nil {
+			return nil, err
+		}
+	}
+	p.checkWarnings(conf.Prefix != "" && !namer.matched)
+	for i, g := range conf.Groups {
+		if g.Prefix != "" && !groupNamers[i].matched {
+			p.warn(WarningPrefixUnmatched, "", "prefix %q of group %s matches none of its files", g.Prefix, g.Name)
+		}
+	}
+	if err := p.checkKeys(archives); err != nil {
+		return nil, err
+	}
+	if err := p.checkBudgets(); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
-func canonicFileName(fname, prefix string) string {
-	fpath := filepath.ToSlash(fname)
-	return path.Join("/", strings.TrimPrefix(fpath, prefix))
+// hasRaw reports whether any file of p is embedded uncompressed.
+func (p *Plan) hasRaw() bool {
+	for _, f := range p.files {
+		if f.Dual || f.Stored {
+			return true
+		}
+	}
+	return false
 }
 
-func (f *_escFile) fillCompressed(gzipLevel int) error {
-	var buf bytes.Buffer
-	gw, err := gzip.NewWriterLevel(&buf, gzipLevel)
+// render writes the generated Go source for p to out, and the test files
+// next to the output file through c.
+func (p *Plan) render(out io.Writer, c *cleanups) error {
+	// Test files are generated before any is written, so most errors leave
+	// nothing to clean up.
+	data, sidecars, err := p.generate()
 	if err != nil {
 		return err
 	}
-	if _, err := gw.Write(f.Data); err != nil {
-		return err
+	if p.conf.UseGoEmbed {
+		if err := p.writeGoEmbedData(c); err != nil {
+			return err
+		}
 	}
-	if err := gw.Close(); err != nil {
-		return err
+	for _, name := range sortedKeys(sidecars) {
+		if err := c.writeFile(name, sidecars[name]); err != nil {
+			return err
+		}
 	}
-	var b bytes.Buffer
-	b64 := base64.NewEncoder(base64.StdEncoding, &b)
-	b64.Write(buf.Bytes())
-	b64.Close()
-	res := "\n"
-	chunk := make([]byte, 80)
-	for n, _ := b.Read(chunk); n > 0; n, _ = b.Read(chunk) {
-		res += string(chunk[0:n]) + "\n"
+	if p.conf.OutputFile != "" {
+		name, err := outputPath(p.conf)
+		if err != nil {
+			return err
+		}
+		stale, err := shardFiles(name)
+		if err != nil {
+			return err
+		}
+		for _, name := range stale {
+			if _, ok := sidecars[name]; ok {
+				continue
+			}
+			if err := c.remove(name); err != nil {
+				return err
+			}
+		}
 	}
 
-	f.Compressed = res
-	return nil
-}
-
-const (
-	fileTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
-
-package {{.PackageName}}
-
-import (
-	"bytes"
-	"compress/gzip"
-	"encoding/base64"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path"
-	"sync"
-	"time"
-)
-
-type _escLocalFS struct{}
-
-var _escLocal _escLocalFS
-
-type _escStaticFS struct{}
-
-var _escStatic _escStaticFS
-
-type _escDirectory struct {
-	fs   http.FileSystem
-	name string
+	return writeSource(out, data, p.files)
 }
 
-type _escFile struct {
-	compressed string
-	size       int64
-	modtime    int64
-	local      string
-	isDir      bool
+// generate returns the generated Go source for p, to be written by
+// writeSource, and the test files to write next to the output file by name.
+func (p *Plan) generate() (data []byte, sidecars map[string][]byte, err error) {
+	conf := p.conf
+	functionPrefix := conf.FunctionPrefix
+	if functionPrefix == "" && conf.Private {
+		functionPrefix = defaultIdentPrefix
+	}
 
-	once sync.Once
-	data []byte
-	name string
-}
+	invocation := scrubInvocation(conf.Invocation, p.root)
+	invocation = truncateInvocation(invocation, len(conf.Files), conf.InvocationLimit)
 
-func (_escLocalFS) Open(name string) (http.File, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
+	var compact *compactLayout
+	if conf.LookupMode == LookupCompact {
+		compact = p.compactLayout()
+	}
+	wrapEmbedVar, goEmbed := conf.WrapEmbedVar, ""
+	if conf.UseGoEmbed {
+		wrapEmbedVar, goEmbed = goEmbedVar, goEmbedDir(conf)
 	}
-	return os.Open(f.local)
-}
 
-func (_escStaticFS) prepare(name string) (*_escFile, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
+	params := templateParams{
+		Invocation:        invocation,
+		PackageName:       conf.Package,
+		ImportPath:        conf.ImportPath,
+		FunctionPrefix:    functionPrefix,
+		Files:             p.files,
+		Dirs:              p.dirs,
+		Tree:              p.Tree(),
+		MetadataOnly:      conf.MetadataOnly,
+		WrapEmbedVar:      wrapEmbedVar,
+		GoEmbedDir:        goEmbed,
+		MutableMetadata:   conf.MutableMetadata,
+		Interface:         conf.Interface,
+		ParseTemplates:    conf.ParseTemplates,
+		ZeroCopy:          conf.ZeroCopy,
+		Encrypted:         len(conf.EncryptionKey) > 0,
+		Verify:            conf.Verify,
+		KeyEnv:            conf.EncryptionKeyEnv,
+		Raw:               p.hasRaw(),
+		Brotli:            p.hasBrotli(),
+		Compression:       compressionFormat(conf),
+		CompressionImport: compressionImports[conf.Compression],
+		Coding:            compressionCodings[conf.Compression],
+		BuildTags:         devConstraint(conf.BuildTags, conf.DevTag, false),
+		StringEncoding:    quoted(conf.Encoding),
+		Sharded:           conf.ShardSize > 0,
+		PatternFiles:      p.patternFiles,
+		Fingerprint:       p.Fingerprint(),
+		BinarySearch:      conf.LookupMode == LookupBinarySearch || conf.LookupMode == LookupCompact,
+		EntryIndex:        p.entryIndex(),
+		Compact:           compact,
+		Groups:            groupParamsOf(conf.Groups),
 	}
+	if conf.PathConstants {
+		if params.PathConstants, err = p.pathConstants(functionPrefix); err != nil {
+			return nil, nil, err
+		}
+	}
+	if conf.CaseInsensitive {
+		if params.Folded, err = p.foldedNames(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if conf.Normalization != "" {
+		params.NFC = p.nfcTables()
+	}
+	params.Blobs = p.blobs()
+	switch {
+	case conf.Encoding == EncodingPacked && !conf.MetadataOnly && conf.WrapEmbedVar == "":
+		params.Packed = p.packedLayout(false)
+	case conf.Encoding == EncodingSidecar:
+		params.Packed = p.packedLayout(true)
+		params.SidecarFile = filepath.Base(sidecarFileName(conf.OutputFile))
+	}
+	switch {
+	case conf.DevTag != "" && conf.LocalEnv != "":
+		params.ForceLocal = "_escLocalEnv()"
+	case conf.DevTag != "":
+		params.ForceLocal = "false"
+	case conf.LocalEnv != "":
+		params.ForceLocal = "useLocal || _escLocalEnv()"
+	}
+	params.LocalEnv = conf.LocalEnv
+	outFileName, err := outputPath(conf)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err = p.execute(params, outFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sidecars = make(map[string][]byte)
+	if params.SidecarFile != "" {
+		sidecars[sidecarFileName(outFileName)] = []byte(params.Packed.Blob)
+	}
+	if conf.DevTag != "" {
+		dev := params
+		dev.BuildTags = devConstraint(conf.BuildTags, conf.DevTag, true)
+		dev.ForceLocal, dev.LocalEnv = "true", ""
+		dev.DevVariant = true
+		dev.WrapEmbedVar, dev.GoEmbedDir = "", ""
+		dev.Raw, dev.Brotli, dev.Sharded = true, false, false
+		dev.Blobs, dev.Packed, dev.SidecarFile = blobs{}, nil, ""
+		name := devFileName(outFileName, conf.DevTag)
+		b, err := p.execute(dev, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		sidecars[name] = b
+	}
+	if conf.Conformance {
+		b, err := p.conformanceTest(invocation, functionPrefix)
+		if err != nil {
+			return nil, nil, err
+		}
+		sidecars[conformanceFileName(outFileName)] = b
+	}
+	if conf.GenerateExamples {
+		b, err := p.examples(invocation, functionPrefix)
+		if err != nil {
+			return nil, nil, err
+		}
+		sidecars[examplesFileName(outFileName)] = b
+	}
+	if conf.JSONManifest {
+		b, err := p.jsonManifest()
+		if err != nil {
+			return nil, nil, err
+		}
+		sidecars[manifestFileName(outFileName)] = b
+	}
+	for _, a := range p.adapters() {
+		b, err := a.source(p, invocation, functionPrefix)
+		if err != nil {
+			return nil, nil, err
+		}
+		sidecars[a.fileName(outFileName)] = b
+	}
+	if conf.ShardSize > 0 {
+		shards, err := p.shardSources(outFileName, invocation, quoted(conf.Encoding))
+		if err != nil {
+			return nil, nil, err
+		}
+		for name, b := range shards {
+			sidecars[name] = b
+		}
+	}
+	return data, sidecars, nil
+}
+
+// execute renders the file template with params and formats it as
+// filename.
+func (p *Plan) execute(params templateParams, filename string) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(buf, params); err != nil {
+		return nil, errors.Wrap(err, "template execution")
+	}
+	src := renameIdents(buf.Bytes(), p.conf.IdentPrefix)
+	return formatCompat(p.conf.FormatCompat, filename, src, p.conf.FormatLocalPrefix)
+}
+
+// sortedKeys returns the keys of files in order.
+func sortedKeys(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func canonicFileName(fname, prefix string) string {
+	fpath := filepath.ToSlash(fname)
+	return path.Join("/", strings.TrimPrefix(fpath, prefix))
+}
+
+// fileNamer computes canonical names for a Prefix that may be given
+// relative while the files are absolute, or the other way around.
+type fileNamer struct {
+	// prefix is the slash separated Prefix as given.
+	prefix string
+	// absPrefix is the absolute, cleaned and slash separated Prefix.
+	absPrefix string
+	cwd       string
+	// matched records whether the prefix was removed from any name.
+	matched bool
+	// mount, if set, is the directory the names are in, e.g. "/templates".
+	mount string
+}
+
+func newFileNamer(prefix string) (*fileNamer, error) {
+	fn := &fileNamer{prefix: filepath.ToSlash(prefix)}
+	if prefix == "" {
+		return fn, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	fn.cwd = cwd
+	fn.absPrefix = fn.abs(prefix)
+	return fn, nil
+}
+
+// abs returns the absolute, cleaned and slash separated form of fname.
+func (fn *fileNamer) abs(fname string) string {
+	if !filepath.IsAbs(fname) {
+		fname = filepath.Join(fn.cwd, fname)
+	}
+	return filepath.ToSlash(filepath.Clean(fname))
+}
+
+// name returns the canonical name of fname. The prefix is removed from fname
+// as given if possible, else from the absolute form of fname.
+func (fn *fileNamer) name(fname string) string {
+	if fn.mount != "" {
+		return path.Join(fn.mount, fn.canonical(fname))
+	}
+	return fn.canonical(fname)
+}
+
+// canonical returns the canonical name of fname before mounting.
+func (fn *fileNamer) canonical(fname string) string {
+	if fn.prefix == "" {
+		return canonicFileName(fname, "")
+	}
+	if strings.HasPrefix(filepath.ToSlash(fname), fn.prefix) {
+		fn.matched = true
+		return canonicFileName(fname, fn.prefix)
+	}
+	if abs := fn.abs(fname); strings.HasPrefix(abs, fn.absPrefix) {
+		fn.matched = true
+		return canonicFileName(abs, fn.absPrefix)
+	}
+	return canonicFileName(fname, fn.prefix)
+}
+
+// fingerprintName returns name with version inserted before its extension,
+// e.g. "/app.js" becomes "/app.0a286891.js".
+func fingerprintName(name, version string) string {
+	ext := path.Ext(name)
+	if ext == path.Base(name) {
+		ext = ""
+	}
+	return strings.TrimSuffix(name, ext) + "." + version + ext
+}
+
+// maxStoredBlock is the largest stored deflate block.
+const maxStoredBlock = 1<<16 - 1
+
+// writeStoredGzip writes b to buf as a gzip stream of stored deflate blocks,
+// laid out like compress/gzip used to at gzip.NoCompression: a
+// header without name and time, a non-final block per 65535 bytes, and an
+// empty final block.
+func writeStoredGzip(buf *bytes.Buffer, b []byte) {
+	buf.Write([]byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, 0, 0xff})
+	var le [4]byte
+	for rest := b; len(rest) > 0; {
+		n := len(rest)
+		if n > maxStoredBlock {
+			n = maxStoredBlock
+		}
+		binary.LittleEndian.PutUint16(le[:], uint16(n))
+		binary.LittleEndian.PutUint16(le[2:], ^uint16(n))
+		buf.WriteByte(0)
+		buf.Write(le[:])
+		buf.Write(rest[:n])
+		rest = rest[n:]
+	}
+	buf.Write([]byte{1, 0, 0, 0xff, 0xff})
+	binary.LittleEndian.PutUint32(le[:], crc32.ChecksumIEEE(b))
+	buf.Write(le[:])
+	binary.LittleEndian.PutUint32(le[:], uint32(len(b)))
+	buf.Write(le[:])
+}
+
+// writeDeflatedGzip writes the raw deflate data of content with the checksum
+// crc and size to buf as a gzip stream.
+func writeDeflatedGzip(buf *bytes.Buffer, deflated []byte, crc uint32, size int) {
+	buf.Write([]byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, 0, 0xff})
+	buf.Write(deflated)
+	var le [4]byte
+	binary.LittleEndian.PutUint32(le[:], crc)
+	buf.Write(le[:])
+	binary.LittleEndian.PutUint32(le[:], uint32(size))
+	buf.Write(le[:])
+}
+
+// maxSize32 is the size of the largest file a 32-bit platform can hold in
+// memory, as slice lengths are int.
+const maxSize32 = math.MaxInt32
+
+// versionLen is the length of the content derived version of a file.
+const versionLen = 8
+
+// contentHash returns the hex encoded SHA-256 digest of b.
+func contentHash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// setData records b as the content of f, which compressFiles compresses.
+func (f *_escFile) setData(b []byte, fingerprint bool) {
+	f.Data = b
+	f.Size = int64(len(b))
+	f.SHA256 = contentHash(b)
+	f.Version = f.SHA256[:versionLen]
+	if fingerprint {
+		f.Fingerprint = fingerprintName(f.Name, f.Version)
+	}
+}
+
+// compressFiles fills the compressed data of files on GOMAXPROCS
+// goroutines, as compression takes most of the time of large runs. It
+// returns the error of the first file that failed.
+func compressFiles(files []*_escFile, gzipLevel int) error {
+	errs := make([]error, len(files))
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for n := runtime.GOMAXPROCS(0); n > 0; n-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				errs[i] = files[i].fillCompressed(gzipLevel)
+			}
+		}()
+	}
+	for i := range files {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeIfSmaller drops the compressed data of f if, base64 encoded unless
+// asString, it is not smaller than Data, which is then embedded as is. This
+// is the case for files in compressed formats such as PNG or woff2.
+func (f *_escFile) storeIfSmaller(asString bool) {
+	size := f.CompressedSize
+	if !asString {
+		size = int64(base64.StdEncoding.EncodedLen(int(size)))
+	}
+	if f.GzipData == nil || size < f.Size {
+		return
+	}
+	f.CompressedSize, f.GzipData, f.Stored = 0, nil, true
+}
+
+func (f *_escFile) fillCompressed(gzipLevel int) error {
+	var buf bytes.Buffer
+	if gzipLevel == gzip.NoCompression {
+		// Stored blocks are written by esc, so they do not change with
+		// compress/flate.
+		writeStoredGzip(&buf, f.Data)
+	} else if f.deflated != nil && (f.compression == "" || f.compression == compressionGzipV1) {
+		// Compressing zip members again would rarely save much.
+		writeDeflatedGzip(&buf, f.deflated, f.crc32, len(f.Data))
+	} else {
+		w, err := newCompressor(&buf, f.compression, gzipLevel)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(f.Data); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+	}
+	f.setGzip(buf.Bytes())
+	return nil
+}
+
+// setGzip records gz as the gzip data of f.
+func (f *_escFile) setGzip(gz []byte) {
+	f.CompressedSize = int64(len(gz))
+	f.GzipData = gz
+}
+
+const (
+	fileTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- range .PatternFiles}}
+// {{.Kind}} {{.Path}} sha256:{{.Hash}}
+{{- end}}
+// fingerprint sha256:{{.Fingerprint}}
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}{{with .ImportPath}} // import "{{.}}"{{end}}
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	{{if .SidecarFile}}_ {{end}}"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing/fstest"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+	{{- with .CompressionImport}}
+
+	"{{.}}"
+	{{- end}}
+)
+
+type _escLocalFS struct{}
+
+var _escLocal _escLocalFS
+
+type _escStaticFS struct{}
+
+var _escStatic _escStaticFS
+{{- with .GoEmbedDir}}
+
+// _escEmbedFS holds the file contents, written to {{.}} by esc.
+{{- if $.Files}}
+//
+//go:embed all:{{.}}
+{{- end}}
+var _escEmbedFS embed.FS
+{{- end}}
+
+type _escDirectory struct {
+	fs   http.FileSystem
+	name string
+}
+
+type _escFile struct {
+	compressed string
+	{{- if .Raw}}
+	// raw is the content, if embedded uncompressed.
+	raw string
+	{{- end}}
+	{{- if .Brotli}}
+	// br is the brotli compressed content, if embedded.
+	br string
+	{{- end}}
+	gzOnce     sync.Once
+	gz         []byte
+	{{- if .Verify}}
+	// err is the error of decompressing or verifying the content.
+	err        error
+	{{- end}}
+	{{- if .Encrypted}}
+	gzErr      error
+	{{- end}}
+	size       int64
+	modtime    int64
+	// mode holds the permission bits of files, 0 if unknown.
+	mode       os.FileMode
+	local      string
+	isDir      bool
+	version    string
+	// hash is the hex encoded SHA-256 of the content, if known.
+	hash string
+	// contentType is the MIME type of the content, if known.
+	contentType string
+	// fingerprint is the name of the file with its version, if fingerprinted.
+	fingerprint string
+	// archive is the local path of the archive the entry was expanded from.
+	archive string
+	{{- if .WrapEmbedVar}}
+	// embed is the path of the file in {{.WrapEmbedVar}}.
+	embed string
+	{{- end}}
+	{{- if and .MetadataOnly .MutableMetadata}}
+	// entry is the entry in _escData the file was copied from.
+	entry *_escFile
+	{{- end}}
+
+	once sync.Once
+	data []byte
+	name string
+}
+
+// _escLookup returns the entry for name and the canonical name it is
+// embedded under.
+func _escLookup(name string) (*_escFile, string, bool) {
+	name = path.Clean(name)
+{{- if .NFC}}
+	name = _escNFC(name)
+{{- end}}
+{{- if .BinarySearch}}
+	if f, present := _escGet(name); present {
+		return f, name, true
+	}
+	if canonical, present := _escFingerprints[name]; present {
+		f, _ := _escGet(canonical)
+		return f, canonical, true
+	}
+{{- if .Folded}}
+	if canonical, present := _escFolded[strings.ToLower(name)]; present {
+		f, _ := _escGet(canonical)
+		return f, canonical, true
+	}
+{{- end}}
+	return nil, "", false
+}
+
+// _escGet returns the entry named name by binary search of the files and
+// then the directories in _escEntries.
+func _escGet(name string) (*_escFile, bool) {
+	for _, r := range [2][2]int{{"{{"}}0, _escFileCount}, {_escFileCount, len(_escEntries)}} {
+		i := r[0] + sort.Search(r[1]-r[0], func(i int) bool { return _escName(r[0]+i) >= name })
+		if i < r[1] && _escName(i) == name {
+			return _escEntries[i], true
+		}
+	}
+	return nil, false
+}
+
+// _escName returns the name of the i-th entry of _escEntries.
+func _escName(i int) string {
+{{- if .Compact}}
+	return _escNameBlob[_escNameOffsets[i]:_escNameOffsets[i+1]]
+{{- else}}
+	return _escNames[i]
+{{- end}}
+}
+{{- else}}
+	if f, present := _escData[name]; present {
+		return f, name, true
+	}
+	if canonical, present := _escFingerprints[name]; present {
+		return _escData[canonical], canonical, true
+	}
+{{- if .Folded}}
+	if canonical, present := _escFolded[strings.ToLower(name)]; present {
+		return _escData[canonical], canonical, true
+	}
+{{- end}}
+	return nil, "", false
+}
+{{- end}}
+{{- if .NFC}}
+
+// _escNFC returns name in normalization form C, as the names are embedded,
+// if it is canonically equivalent to an embedded name, whatever the runes it
+// is written with. It decomposes name, puts its combining marks in canonical
+// order and composes it again, with the Unicode data of the embedded names
+// only.
+func _escNFC(name string) string {
+	ascii := true
+	for i := 0; i < len(name) && ascii; i++ {
+		ascii = name[i] < utf8.RuneSelf
+	}
+	if ascii {
+		return name
+	}
+	runes := make([]rune, 0, len(name))
+	for _, r := range name {
+		if d, present := _escDecompositions[r]; present {
+			runes = append(runes, d...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+	for i := 1; i < len(runes); i++ {
+		for j := i; j > 0 && _escCombiningClasses[runes[j]] != 0 && _escCombiningClasses[runes[j-1]] > _escCombiningClasses[runes[j]]; j-- {
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+	composed := runes[:0]
+	starter, last := -1, uint8(0)
+	for _, r := range runes {
+		class := _escCombiningClasses[r]
+		if starter >= 0 && (starter == len(composed)-1 || last != 0 && last < class) {
+			if c, present := _escCompositions[[2]rune{composed[starter], r}]; present {
+				composed[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(composed)
+		}
+		last = class
+		composed = append(composed, r)
+	}
+	return string(composed)
+}
+{{- end}}
+
+func (_escLocalFS) Open(name string) (http.File, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	if f.local == "" || f.archive != "" {
+		// Inline files and archive members only exist embedded.
+		return _escStatic.Open(name)
+	}
+	local := _escLocalPath(f.local)
+	file, err := os.Open(local)
+	if err != nil {
+		return nil, _escLocalError(name, err)
+	}
+	// A directory replaced by a file or the other way round must not be
+	// served with the metadata recorded for the other type.
+	fi, err := file.Stat()
+	if err == nil && fi.IsDir() != f.isDir {
+		err = _escTypeChangedError(name, f.isDir)
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, fingerprinted := _escFingerprints[path.Clean(name)]; fingerprinted {
+		// The file on disk must still have the content the name was derived from.
+		b, err := ioutil.ReadFile(local)
+		if err != nil {
+			file.Close()
+			return nil, _escLocalError(name, err)
+		}
+		sum := sha256.Sum256(b)
+		if hex.EncodeToString(sum[:])[:len(f.version)] != f.version {
+			file.Close()
+			return nil, os.ErrNotExist
+		}
+	}
+	return &_escLocalFile{File: file}, nil
+}
+
+// {{.FunctionPrefix}}ErrTypeChanged is returned in local mode when an embedded file is a
+// directory on disk, or an embedded directory a file.
+var {{.FunctionPrefix}}ErrTypeChanged = errors.New("esc: file type changed on disk")
+
+func _escTypeChangedError(name string, wasDir bool) error {
+	embedded, local := "file", "directory"
+	if wasDir {
+		embedded, local = local, embedded
+	}
+	return fmt.Errorf("%w: %s is embedded as a %s but is a %s on disk, regenerate the assets", {{.FunctionPrefix}}ErrTypeChanged, path.Clean(name), embedded, local)
+}
+
+var (
+	_escLocalRootsMu sync.RWMutex
+	_escLocalRoots   = map[string]string{}
+)
+
+// {{.FunctionPrefix}}FSSetLocalRoot makes local mode read files recorded below the directory
+// old from the directory new instead, e.g. when the assets are vendored into
+// another checkout. old is matched against the recorded local paths, which
+// are slash separated and relative to the project root unless esc was run
+// with -absolute-paths; an old of "." matches all relative paths. If several
+// roots match, the longest wins. An empty new
+// removes the mapping of old. It is safe to call concurrently with opening
+// files.
+func {{.FunctionPrefix}}FSSetLocalRoot(old, new string) {
+	old = path.Clean(filepath.ToSlash(old))
+	_escLocalRootsMu.Lock()
+	defer _escLocalRootsMu.Unlock()
+	if new == "" {
+		delete(_escLocalRoots, old)
+	} else {
+		_escLocalRoots[old] = new
+	}
+}
+
+// _escLocalPath returns the path local is read from in local mode.
+func _escLocalPath(local string) string {
+	_escLocalRootsMu.RLock()
+	defer _escLocalRootsMu.RUnlock()
+	best, rest := "", local
+	for old := range _escLocalRoots {
+		if len(old) <= len(best) {
+			continue
+		}
+		switch {
+		case old == "." && !path.IsAbs(local):
+			best, rest = old, local
+		case local == old || strings.HasPrefix(local, strings.TrimSuffix(old, "/")+"/"):
+			best, rest = old, strings.TrimPrefix(local, old)
+		}
+	}
+	if best == "" {
+		return local
+	}
+	return filepath.Join(_escLocalRoots[best], filepath.FromSlash(rest))
+}
+
+// _escLocalError describes a file of name missing on disk in local mode. It
+// still matches fs.ErrNotExist with errors.Is.
+func _escLocalError(name string, err error) error {
+	if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return fmt.Errorf("esc: %s is embedded but missing on disk for local mode, use {{.FunctionPrefix}}FSSetLocalRoot if the files moved: %w", path.Clean(name), err)
+}
+
+// _escLocalFile lists directories sorted by name like the embedded files,
+// independent of the order the operating system returns.
+type _escLocalFile struct {
+	*os.File
+	fis    []os.FileInfo
+	listed bool
+	dirPos int
+}
+
+func (f *_escLocalFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		fis, err := f.File.Readdir(-1)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+		f.fis, f.listed = fis, true
+	}
+	return _escReaddir(f.fis, &f.dirPos, count)
+}
+
+func (f *_escLocalFile) ReadDir(count int) ([]fs.DirEntry, error) {
+	fis, err := f.Readdir(count)
+	des := make([]fs.DirEntry, len(fis))
+	for i, fi := range fis {
+		des[i] = fs.FileInfoToDirEntry(fi)
+	}
+	return des, err
+}
+
+{{if .MetadataOnly -}}
+// {{.FunctionPrefix}}ErrNotEmbedded is returned when opening a file while no fetch function is
+// registered with {{.FunctionPrefix}}FSSetFetch, as file contents are not embedded.
+var {{.FunctionPrefix}}ErrNotEmbedded = errors.New("esc: file content is not embedded")
+
+var _escFetch func(name string) ([]byte, error)
+
+// {{.FunctionPrefix}}FSSetFetch registers fetch to load the content of the named file when it
+// is opened from the embedded assets, which only hold metadata. It must not be
+// called concurrently with opening files.
+func {{.FunctionPrefix}}FSSetFetch(fetch func(name string) ([]byte, error)) {
+	_escFetch = fetch
+}
+
+func (_escStaticFS) prepare(name string) (*_escFile, error) {
+	f, name, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	if f.size == 0 {
+		return f, nil
+	}
+	if _escFetch == nil {
+		return nil, {{.FunctionPrefix}}ErrNotEmbedded
+	}
+	data, err := _escFetch(name)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != f.size {
+		return nil, fmt.Errorf("esc: fetched %d bytes for %s, want %d", len(data), name, f.size)
+	}
+	return &_escFile{
+		name:    f.name,
+		local:   f.local,
+		size:    f.size,
+		modtime: f.modtime,
+		mode:    f.mode,
+		data:    data,
+		{{- if .MutableMetadata}}
+		entry:   f,
+		{{- end}}
+	}, nil
+}
+{{- else if .WrapEmbedVar -}}
+func (_escStaticFS) prepare(name string) (*_escFile, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	var err error
+	f.once.Do(func() {
+		if f.isDir {
+			return
+		}
+		f.data, err = {{.WrapEmbedVar}}.ReadFile(f.embed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// {{.FunctionPrefix}}FSSelfCheck reports files recorded at generation that are missing from
+// {{.WrapEmbedVar}} or differ from it, which happens when the go:embed patterns
+// do not match the files esc was run on.
+func {{.FunctionPrefix}}FSSelfCheck() error {
+	var msgs []string
+{{- if .BinarySearch}}
+	for i := 0; i < _escFileCount; i++ {
+		name, f := _escName(i), _escEntries[i]
+{{- else}}
+	names := make([]string, 0, len(_escData))
+	for name := range _escData {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := _escData[name]
+		if f.isDir {
+			continue
+		}
+{{- end}}
+		b, err := {{.WrapEmbedVar}}.ReadFile(f.embed)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		sum := sha256.Sum256(b)
+		if int64(len(b)) != f.size || hex.EncodeToString(sum[:])[:len(f.version)] != f.version {
+			msgs = append(msgs, fmt.Sprintf("%s: %s differs from the file esc was run on", name, f.embed))
+		}
+	}
+	if len(msgs) > 0 {
+		return errors.New("esc: " + strings.Join(msgs, "; "))
+	}
+	return nil
+}
+{{- else -}}
+func (_escStaticFS) prepare(name string) (*_escFile, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	{{- if .DevVariant}}
+	if f.local != "" && f.archive == "" && !f.isDir {
+		// The development variant reads files from disk on every call.
+		data, err := ioutil.ReadFile(_escLocalPath(f.local))
+		if err != nil {
+			return nil, _escLocalError(name, err)
+		}
+		return &_escFile{
+			name:        f.name,
+			local:       f.local,
+			size:        int64(len(data)),
+			modtime:     f.modtime,
+			mode:        f.mode,
+			contentType: f.contentType,
+			data:        data,
+		}, nil
+	}
+	{{- end}}
+	{{- if .Encrypted}}
+	if f.size != 0 {
+		// Decrypting outside once keeps a missing key from breaking the
+		// file for good.
+		if _, err := _escGzip(f); err != nil {
+			return nil, err
+		}
+	}
+	{{- end}}
 	var err error
 	f.once.Do(func() {
-		f.name = path.Base(name)
+		{{- if .Verify}}
+		defer func() { err = _escVerify(name, f, err) }()
+		{{- end}}
 		if f.size == 0 {
 			return
 		}
-		var gr *gzip.Reader
-		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
-		gr, err = gzip.NewReader(b64)
+		{{- if .Raw}}
+		if f.raw != "" {
+			{{- if .ZeroCopy}}
+			f.data = _escBytes(f.raw)
+			{{- else}}
+			f.data = []byte(f.raw)
+			{{- end}}
+			return
+		}
+		{{- end}}
+		if _escOnDecompress != nil {
+			_escOnDecompress(name)
+		}
+		{{- if .Compression}}
+		var r io.Reader
+		{{- if .Encrypted}}
+		r = bytes.NewReader(f.gz)
+		{{- else if .StringEncoding}}
+		r = strings.NewReader(f.compressed)
+		{{- else}}
+		r = base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
+		{{- end}}
+		f.data, err = _escDecompress(r)
+		{{- else}}
+		var gr *gzip.Reader
+		{{- if .Encrypted}}
+		gr, err = gzip.NewReader(bytes.NewReader(f.gz))
+		{{- else if .StringEncoding}}
+		gr, err = gzip.NewReader(strings.NewReader(f.compressed))
+		{{- else}}
+		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
+		gr, err = gzip.NewReader(b64)
+		{{- end}}
+		if err != nil {
+			return
+		}
+		f.data, err = ioutil.ReadAll(gr)
+		{{- end}}
+	})
+	{{- if .Verify}}
+	if f.err != nil {
+		return nil, f.err
+	}
+	{{- end}}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+{{- if .Verify}}
+
+// _escVerify checks the content of f, just decompressed with err, against
+// its hash. It records err or a mismatch as the error of every read of f.
+func _escVerify(name string, f *_escFile, err error) error {
+	if err == nil && f.hash != "" {
+		sum := sha256.Sum256(f.data)
+		if got := hex.EncodeToString(sum[:]); got != f.hash {
+			err = fmt.Errorf("SHA-256 %s, want %s", got, f.hash)
+		}
+	}
+	if err != nil {
+		f.data = nil
+		f.err = fmt.Errorf("esc: %s: embedded data is corrupted: %v", name, err)
+	}
+	return f.err
+}
+{{- end}}
+
+// _escOnDecompress, if set, is called with the name of every file when it is
+// decompressed.
+var _escOnDecompress func(name string)
+{{- if eq .Compression "brotli"}}
+
+// _escDecompress returns the content read brotli compressed from r.
+func _escDecompress(r io.Reader) ([]byte, error) {
+	return ioutil.ReadAll(brotli.NewReader(r))
+}
+{{- else if eq .Compression "zstd"}}
+
+// _escDecompress returns the content read zstd compressed from r.
+func _escDecompress(r io.Reader) ([]byte, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return ioutil.ReadAll(zr)
+}
+{{- end}}
+{{- if .ZeroCopy}}
+
+// _escBytes returns the bytes of s without copying them, so they must not
+// be modified.
+func _escBytes(s string) []byte {
+	return *(*[]byte)(unsafe.Pointer(&struct {
+		string
+		int
+	}{s, len(s)}))
+}
+{{- end}}
+{{- if not .Compression}}
+
+// {{.FunctionPrefix}}FSGzipByte returns the gzip data embedded for the named file, e.g. to
+// serve it with Content-Encoding gzip, without compressing or decompressing
+// it. It fails for files embedded uncompressed only, which gzip does not make
+// smaller. The returned slice must not be modified.
+func {{.FunctionPrefix}}FSGzipByte(name string) ([]byte, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	if f.isDir {
+		return nil, &os.PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	if f.compressed == "" {
+		return nil, &os.PathError{Op: "read", Path: name, Err: errors.New("is not gzip compressed")}
+	}
+	return _escGzip(f)
+}
+{{- end}}
+{{- end}}
+
+// _escGzip returns the gzip data embedded for f, which must not be empty.
+func _escGzip(f *_escFile) ([]byte, error) {
+{{- if .Encrypted}}
+	aead, err := _escCipher()
+	if err != nil {
+		return nil, err
+	}
+	f.gzOnce.Do(func() {
+		{{- if .StringEncoding}}
+		sealed := []byte(f.compressed)
+		{{- else}}
+		sealed, err := base64.StdEncoding.DecodeString(f.compressed)
+		if err != nil {
+			f.gzErr = err
+			return
+		}
+		{{- end}}
+		n := aead.NonceSize()
+		if len(sealed) < n {
+			f.gzErr = errors.New("esc: encrypted data too short")
+			return
+		}
+		if f.gz, err = aead.Open(nil, sealed[:n], sealed[n:], nil); err != nil {
+			f.gzErr = fmt.Errorf("esc: decrypt: %v", err)
+		}
+	})
+	return f.gz, f.gzErr
+{{- else}}
+	var err error
+	f.gzOnce.Do(func() {
+		{{- if .StringEncoding}}
+		f.gz = []byte(f.compressed)
+		{{- else}}
+		f.gz, err = base64.StdEncoding.DecodeString(f.compressed)
+		{{- end}}
+	})
+	return f.gz, err
+{{- end}}
+}
+{{- if .Encrypted}}
+
+var (
+	_escKeyMu sync.Mutex
+	_escAEAD  cipher.AEAD
+)
+
+// {{.FunctionPrefix}}FSSetKey sets the AES key decrypting the embedded files{{with .KeyEnv}}, which
+// is otherwise read hex encoded from ${{.}}{{end}}. It must be called before
+// any file is read.
+func {{.FunctionPrefix}}FSSetKey(key []byte) error {
+	aead, err := _escNewAEAD(key)
+	if err != nil {
+		return err
+	}
+	_escKeyMu.Lock()
+	_escAEAD = aead
+	_escKeyMu.Unlock()
+	return nil
+}
+
+// _escCipher returns the cipher of the key set with {{.FunctionPrefix}}FSSetKey{{with .KeyEnv}}, else
+// of that in ${{.}}{{end}}.
+func _escCipher() (cipher.AEAD, error) {
+	_escKeyMu.Lock()
+	defer _escKeyMu.Unlock()
+	if _escAEAD != nil {
+		return _escAEAD, nil
+	}
+	{{- with .KeyEnv}}
+	if hexKey := os.Getenv("{{.}}"); hexKey != "" {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("esc: ${{.}}: %v", err)
+		}
+		aead, err := _escNewAEAD(key)
 		if err != nil {
-			return
+			return nil, err
 		}
-		f.data, err = ioutil.ReadAll(gr)
+		_escAEAD = aead
+		return aead, nil
+	}
+	return nil, errors.New("esc: no key to decrypt the embedded files, set ${{.}} or call {{$.FunctionPrefix}}FSSetKey")
+	{{- else}}
+	return nil, errors.New("esc: no key to decrypt the embedded files, call {{.FunctionPrefix}}FSSetKey")
+	{{- end}}
+}
+
+// _escNewAEAD returns the AES-GCM cipher of key.
+func _escNewAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("esc: key: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+{{- end}}
+
+func (fs _escStaticFS) Open(name string) (http.File, error) {
+	f, err := fs.prepare(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.File()
+}
+
+func (dir _escDirectory) Open(name string) (http.File, error) {
+	return dir.fs.Open(dir.name + name)
+}
+
+type _escOpenFile struct {
+	*bytes.Reader
+	*_escFile
+	dirPos int
+}
+
+// Embedded files support random access, e.g. by zip.NewReader, like local
+// ones.
+var _ io.ReaderAt = (*_escOpenFile)(nil)
+
+func (f *_escFile) File() (http.File, error) {
+	return &_escOpenFile{
+		Reader:   bytes.NewReader(f.data),
+		_escFile: f,
+	}, nil
+}
+
+// Readdir continues reading the directory where the previous call stopped.
+func (f *_escOpenFile) Readdir(count int) ([]os.FileInfo, error) {
+	fis, err := f._escFile.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	return _escReaddir(fis, &f.dirPos, count)
+}
+
+func (f *_escOpenFile) ReadDir(count int) ([]fs.DirEntry, error) {
+	fis, err := f.Readdir(count)
+	des := make([]fs.DirEntry, len(fis))
+	for i, fi := range fis {
+		des[i] = fs.FileInfoToDirEntry(fi)
+	}
+	return des, err
+}
+
+// _escReaddir returns the next count entries of fis after *pos, following
+// the semantics of os.File.Readdir, and advances *pos.
+func _escReaddir(fis []os.FileInfo, pos *int, count int) ([]os.FileInfo, error) {
+	fis = fis[*pos:]
+	if count > 0 {
+		if len(fis) == 0 {
+			return nil, io.EOF
+		}
+		if count < len(fis) {
+			fis = fis[:count]
+		}
+	}
+	*pos += len(fis)
+	return fis, nil
+}
+
+func (f *_escFile) Close() error {
+	return nil
+}
+
+func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.isDir {
+		return nil, fmt.Errorf(" escFile.Readdir: '%s' is not directory", f.name)
+	}
+
+	fis, ok := _escDirs[f.local]
+	if !ok {
+		return nil, fmt.Errorf(" escFile.Readdir: '%s' is directory, but we have no info about content of this dir, local=%s", f.name, f.local)
+	}
+	limit := count
+	if count <= 0 || limit > len(fis) {
+		limit = len(fis)
+	}
+
+	if len(fis) == 0 && count > 0 {
+		return nil, io.EOF
+	}
+
+	return fis[0:limit], nil
+}
+
+
+func (f *_escFile) Stat() (os.FileInfo, error) {
+	return f, nil
+}
+
+func (f *_escFile) Name() string {
+	return f.name
+}
+
+func (f *_escFile) Size() int64 {
+	return f.size
+}
+
+func (f *_escFile) Mode() os.FileMode {
+	if f.isDir {
+		return os.ModeDir
+	}
+	return f.mode
+}
+
+func (f *_escFile) ModTime() time.Time {
+{{- if .MutableMetadata}}
+	entry := f
+	{{- if .MetadataOnly}}
+	if f.entry != nil {
+		entry = f.entry
+	}
+	{{- end}}
+	if t, ok := _escModTimes.Load(entry); ok {
+		return t.(time.Time)
+	}
+{{- end}}
+	return time.Unix(f.modtime, 0)
+}
+{{- if .MutableMetadata}}
+
+// _escModTimes maps entries of _escData to the modification times set with
+// {{.FunctionPrefix}}FSSetModTime.
+var _escModTimes sync.Map
+
+// {{.FunctionPrefix}}FSSetModTime overrides the modification time reported for the named
+// embedded file or directory, and so the Last-Modified header of
+// {{.FunctionPrefix}}FSHandler, until {{.FunctionPrefix}}FSResetModTimes is called. Its content and version
+// are not affected. It is safe to call concurrently with reading files.
+func {{.FunctionPrefix}}FSSetModTime(name string, t time.Time) error {
+	f, _, present := _escLookup(name)
+	if !present {
+		return os.ErrNotExist
+	}
+	_escModTimes.Store(f, t)
+	return nil
+}
+
+// {{.FunctionPrefix}}FSResetModTimes undoes all {{.FunctionPrefix}}FSSetModTime calls.
+func {{.FunctionPrefix}}FSResetModTimes() {
+	_escModTimes.Range(func(f, _ interface{}) bool {
+		_escModTimes.Delete(f)
+		return true
+	})
+}
+{{- end}}
+
+func (f *_escFile) IsDir() bool {
+	return f.isDir
+}
+
+func (f *_escFile) Sys() interface{} {
+	return f
+}
+
+// {{.FunctionPrefix}}FS returns a http.Filesystem for the embedded assets. If useLocal is true,
+// the filesystem's contents are instead used.
+{{- with .LocalEnv}} They are also used, by all functions
+// taking useLocal, while ${{.}} is set to a true value such as 1.
+{{- end}}
+func {{.FunctionPrefix}}FS(useLocal bool) http.FileSystem {
+	{{- with .ForceLocal}}
+	useLocal = {{.}}
+	{{- end}}
+	if useLocal {
+		return _escLocal
+	}
+	return _escStatic
+}
+
+{{- with .LocalEnv}}
+
+// _escLocalEnv reports whether ${{.}} is set to a true value such as 1,
+// which forces local mode.
+func _escLocalEnv() bool {
+	local, _ := strconv.ParseBool(os.Getenv({{printf "%q" .}}))
+	return local
+}
+{{- end}}
+
+// {{.FunctionPrefix}}Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
+// If useLocal is true, the filesystem's contents are instead used.
+func {{.FunctionPrefix}}Dir(useLocal bool, name string) http.FileSystem {
+	{{- with .ForceLocal}}
+	useLocal = {{.}}
+	{{- end}}
+	if useLocal {
+		return _escDirectory{fs: _escLocal, name: name}
+	}
+	return _escDirectory{fs: _escStatic, name: name}
+}
+
+// {{.FunctionPrefix}}IOFS returns the embedded assets as an fs.FS, e.g. for template.ParseFS,
+// with names like "css/main.css" instead of "/css/main.css". If useLocal is
+// true, the filesystem's contents are instead used.
+func {{.FunctionPrefix}}IOFS(useLocal bool) fs.FS {
+	return _escIOFSys{fs: {{.FunctionPrefix}}FS(useLocal)}
+}
+
+// {{.FunctionPrefix}}FSWalk walks the embedded tree rooted at root, e.g. "/" or "/css", like
+// fs.WalkDir, calling fn for every file and directory in sorted order with
+// canonical names such as "/css/main.css".
+func {{.FunctionPrefix}}FSWalk(root string, fn fs.WalkDirFunc) error {
+	name := strings.TrimPrefix(path.Clean("/"+root), "/")
+	if name == "" {
+		name = "."
+	}
+	return fs.WalkDir({{.FunctionPrefix}}IOFS(false), name, func(name string, d fs.DirEntry, err error) error {
+		return fn(path.Join("/", name), d, err)
 	})
+}
+{{- if .ParseTemplates}}
+
+// {{.FunctionPrefix}}ParseTemplates parses the embedded files matching patterns, with the
+// syntax of {{.FunctionPrefix}}FSGlob, or all files if there are none, as html/template
+// templates named by their canonical names, e.g. "/layouts/base.html", with
+// funcs available to them. It returns an error if a pattern matches no file.
+func {{.FunctionPrefix}}ParseTemplates(funcs template.FuncMap, patterns ...string) (*template.Template, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"/**"}
+	}
+	t := template.New("").Funcs(funcs)
+	for _, pattern := range patterns {
+		parsed := false
+		for _, name := range {{.FunctionPrefix}}FSGlob(pattern) {
+			if fi, err := {{.FunctionPrefix}}FSStat(name); err != nil || fi.IsDir() {
+				continue
+			}
+			b, err := {{.FunctionPrefix}}FSByte(false, name)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := t.New(name).Parse(string(b)); err != nil {
+				return nil, err
+			}
+			parsed = true
+		}
+		if !parsed {
+			return nil, fmt.Errorf("pattern %q matches no embedded template", pattern)
+		}
+	}
+	return t, nil
+}
+{{- end}}
+
+// _escIOFSys adapts the http.FileSystem implementations, whose Open method
+// cannot also implement fs.FS.
+type _escIOFSys struct {
+	fs http.FileSystem
+}
+
+func (f _escIOFSys) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	file, err := f.fs.Open(path.Join("/", name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return file, nil
+}
+
+// {{.FunctionPrefix}}FSRestricted returns a http.Filesystem serving only the embedded assets
+// named in allowed, exact names or path.Match patterns such as "/css/*.css",
+// and the directories containing them. Opening any other name fails as if it
+// were not embedded, and directory listings only include allowed entries. It
+// returns an error if a pattern is malformed or matches nothing.
+// If useLocal is true, the filesystem's contents are instead used.
+func {{.FunctionPrefix}}FSRestricted(useLocal bool, allowed ...string) (http.FileSystem, error) {
+	names := make(map[string]bool)
+	for _, pattern := range allowed {
+		pattern = path.Clean("/" + pattern)
+		matched := false
+		{{- if .BinarySearch}}
+		for i := range _escEntries {
+			name := _escName(i)
+		{{- else}}
+		for name := range _escData {
+		{{- end}}
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, fmt.Errorf("esc: %s: %v", pattern, err)
+			}
+			if ok {
+				names[name], matched = true, true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("esc: %s matches no embedded file", pattern)
+		}
+	}
+	for name := range names {
+		for dir := path.Dir(name); !names[dir]; dir = path.Dir(dir) {
+			names[dir] = true
+		}
+	}
+	return _escRestrictedFS{fs: {{.FunctionPrefix}}FS(useLocal), names: names}, nil
+}
+
+type _escRestrictedFS struct {
+	fs    http.FileSystem
+	names map[string]bool
+}
+
+func (r _escRestrictedFS) Open(name string) (http.File, error) {
+	_, canonical, present := _escLookup(path.Clean("/" + name))
+	if !present || !r.names[canonical] {
+		return nil, os.ErrNotExist
+	}
+	f, err := r.fs.Open(path.Clean("/" + name))
+	if err != nil {
+		return nil, err
+	}
+	return &_escRestrictedFile{File: f, fs: r, name: canonical}, nil
+}
+
+// _escRestrictedFile lists only the allowed entries of a directory.
+type _escRestrictedFile struct {
+	http.File
+	fs     _escRestrictedFS
+	name   string
+	fis    []os.FileInfo
+	listed bool
+	dirPos int
+}
+
+// ReadAt reads from the underlying file, embedded or local, which implements
+// io.ReaderAt.
+func (f *_escRestrictedFile) ReadAt(b []byte, off int64) (int, error) {
+	return f.File.(io.ReaderAt).ReadAt(b, off)
+}
+
+func (f *_escRestrictedFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		fis, err := f.File.Readdir(-1)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range fis {
+			if f.fs.names[path.Join(f.name, fi.Name())] {
+				f.fis = append(f.fis, fi)
+			}
+		}
+		f.listed = true
+	}
+	return _escReaddir(f.fis, &f.dirPos, count)
+}
+
+// {{.FunctionPrefix}}FSStat returns information about the named file or directory in the
+// embedded assets without loading its content.
+func {{.FunctionPrefix}}FSStat(name string) (os.FileInfo, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return nil, os.ErrNotExist
+	}
+	return f, nil
+}
+
+// {{.FunctionPrefix}}FSByte returns the named file from the embedded assets. If useLocal is
+// true, the filesystem's contents are instead used.
+{{- if .ZeroCopy}}
+// Embedded files are returned without copying them from read-only memory,
+// so the returned slice must not be modified.
+{{- end}}
+func {{.FunctionPrefix}}FSByte(useLocal bool, name string) ([]byte, error) {
+	{{- with .ForceLocal}}
+	useLocal = {{.}}
+	{{- end}}
+	if useLocal {
+		f, err := _escLocal.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ioutil.ReadAll(f)
+		_ = f.Close()
+		return b, err
+	}
+	f, err := _escStatic.prepare(name)
 	if err != nil {
 		return nil, err
 	}
-	return f, nil
+	return f.data, nil
+}
+
+// {{.FunctionPrefix}}FSMustByte is the same as {{.FunctionPrefix}}FSByte, but panics if name is not present.
+func {{.FunctionPrefix}}FSMustByte(useLocal bool, name string) []byte {
+	b, err := {{.FunctionPrefix}}FSByte(useLocal, name)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// {{.FunctionPrefix}}FSString is the string version of {{.FunctionPrefix}}FSByte.
+{{- if and .ZeroCopy (not .DevVariant)}} Embedded files
+// are returned as the embedded string constants, without copying them.
+{{- end}}
+func {{.FunctionPrefix}}FSString(useLocal bool, name string) (string, error) {
+	{{- if and .ZeroCopy .Raw (not .DevVariant)}}
+	{{- with .ForceLocal}}
+	useLocal = {{.}}
+	{{- end}}
+	if f, _, present := _escLookup(name); present && !useLocal && f.raw != "" {
+		return f.raw, nil
+	}
+	{{- end}}
+	b, err := {{.FunctionPrefix}}FSByte(useLocal, name)
+	return string(b), err
+}
+
+// {{.FunctionPrefix}}FSMustString is the string version of {{.FunctionPrefix}}FSMustByte.
+func {{.FunctionPrefix}}FSMustString(useLocal bool, name string) string {
+	{{- if .ZeroCopy}}
+	s, err := {{.FunctionPrefix}}FSString(useLocal, name)
+	if err != nil {
+		panic(err)
+	}
+	return s
+	{{- else}}
+	return string({{.FunctionPrefix}}FSMustByte(useLocal, name))
+	{{- end}}
+}
+{{- range .Groups}}
+
+// {{$.FunctionPrefix}}{{.Func}}FS returns a http.Filesystem for the assets of the group {{.Name}},
+// embedded in /{{.Name}}. If useLocal is true, the filesystem's contents are
+// instead used.
+func {{$.FunctionPrefix}}{{.Func}}FS(useLocal bool) http.FileSystem {
+	return {{$.FunctionPrefix}}Dir(useLocal, "/{{.Name}}")
+}
+
+// {{$.FunctionPrefix}}{{.Func}}IOFS is {{$.FunctionPrefix}}IOFS for the group {{.Name}}.
+func {{$.FunctionPrefix}}{{.Func}}IOFS(useLocal bool) fs.FS {
+	return _escIOFSys{fs: {{$.FunctionPrefix}}{{.Func}}FS(useLocal)}
+}
+
+// {{$.FunctionPrefix}}{{.Func}}FSByte is {{$.FunctionPrefix}}FSByte for the group {{.Name}}.
+func {{$.FunctionPrefix}}{{.Func}}FSByte(useLocal bool, name string) ([]byte, error) {
+	return {{$.FunctionPrefix}}FSByte(useLocal, "/{{.Name}}"+path.Clean("/"+name))
+}
+
+// {{$.FunctionPrefix}}{{.Func}}FSMustByte is {{$.FunctionPrefix}}FSMustByte for the group {{.Name}}.
+func {{$.FunctionPrefix}}{{.Func}}FSMustByte(useLocal bool, name string) []byte {
+	return {{$.FunctionPrefix}}FSMustByte(useLocal, "/{{.Name}}"+path.Clean("/"+name))
+}
+
+// {{$.FunctionPrefix}}{{.Func}}FSString is {{$.FunctionPrefix}}FSString for the group {{.Name}}.
+func {{$.FunctionPrefix}}{{.Func}}FSString(useLocal bool, name string) (string, error) {
+	return {{$.FunctionPrefix}}FSString(useLocal, "/{{.Name}}"+path.Clean("/"+name))
+}
+
+// {{$.FunctionPrefix}}{{.Func}}FSMustString is {{$.FunctionPrefix}}FSMustString for the group {{.Name}}.
+func {{$.FunctionPrefix}}{{.Func}}FSMustString(useLocal bool, name string) string {
+	return {{$.FunctionPrefix}}FSMustString(useLocal, "/{{.Name}}"+path.Clean("/"+name))
+}
+{{- end}}
+
+// {{.FunctionPrefix}}FSInstallDefaults writes embedded files to disk unless they already exist.
+// mapping maps embedded names to destination paths. Parent directories are
+// created as needed, and written files get the embedded modification time
+// and mode, or 0644 if the mode is unknown. Destinations are created
+// exclusively, so concurrent calls never overwrite each other. The
+// destinations actually written are returned sorted; errors for single
+// files are collected into the returned error.
+func {{.FunctionPrefix}}FSInstallDefaults(mapping map[string]string) ([]string, error) {
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return mapping[names[i]] < mapping[names[j]] })
+	var written, errs []string
+	for _, name := range names {
+		dest := mapping[name]
+		ok, err := _escInstall(name, dest)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s -> %s: %v", name, dest, err))
+		} else if ok {
+			written = append(written, dest)
+		}
+	}
+	if len(errs) > 0 {
+		return written, fmt.Errorf("esc: install defaults: %s", strings.Join(errs, "; "))
+	}
+	return written, nil
 }
 
-func (fs _escStaticFS) Open(name string) (http.File, error) {
-	f, err := fs.prepare(name)
+func _escInstall(name, dest string) (bool, error) {
+	f, err := _escStatic.prepare(name)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	return f.File()
+	if f.isDir {
+		return false, errors.New("is a directory")
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return false, err
+	}
+	perm := f.Mode().Perm()
+	if perm == 0 {
+		perm = 0644
+	}
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if os.IsExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	_, err = out.Write(f.data)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chtimes(dest, f.ModTime(), f.ModTime())
+	}
+	if err != nil {
+		os.Remove(dest)
+		return false, err
+	}
+	return true, nil
 }
 
-func (dir _escDirectory) Open(name string) (http.File, error) {
-	return dir.fs.Open(dir.name + name)
+// {{.FunctionPrefix}}FSVersion returns a short token derived from the content of the named
+// file, which changes whenever the content changes. It is suitable for cache
+// busting query strings.
+func {{.FunctionPrefix}}FSVersion(name string) (string, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return "", os.ErrNotExist
+	}
+	if f.version == "" {
+		return "", fmt.Errorf("esc: no version for %s", path.Clean(name))
+	}
+	return f.version, nil
 }
 
-func (f *_escFile) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_escFile
+// {{.FunctionPrefix}}FSFingerprinted returns the fingerprinted name of the embedded file name,
+// e.g. "/app.0a286891.js" for "/app.js", to link to it with far-future
+// caching. It returns name unchanged if the file is not fingerprinted, see
+// the -fingerprint flag of esc. Its type suits a template.FuncMap entry.
+func {{.FunctionPrefix}}FSFingerprinted(name string) string {
+	f, _, present := _escLookup(name)
+	if !present || f.fingerprint == "" {
+		return name
 	}
-	return &httpFile{
-		Reader:   bytes.NewReader(f.data),
-		_escFile: f,
-	}, nil
+	return f.fingerprint
 }
 
-func (f *_escFile) Close() error {
-	return nil
+// {{.FunctionPrefix}}FSManifest returns a new map from the canonical names of the fingerprinted
+// files to their fingerprinted names, e.g. for html/template data or to
+// write out for other tools.
+func {{.FunctionPrefix}}FSManifest() map[string]string {
+	manifest := make(map[string]string, len(_escFingerprints))
+	for fingerprinted, canonical := range _escFingerprints {
+		manifest[canonical] = fingerprinted
+	}
+	return manifest
 }
 
-func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
-	if !f.isDir {
-		return nil, fmt.Errorf(" escFile.Readdir: '%s' is not directory", f.name)
+// {{.FunctionPrefix}}FSHash returns the hex encoded SHA-256 of the content of the embedded file
+// name, computed when it was embedded, e.g. for a strong ETag.
+func {{.FunctionPrefix}}FSHash(name string) (string, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return "", os.ErrNotExist
+	}
+	if f.hash == "" {
+		return "", fmt.Errorf("esc: no hash for %s", path.Clean(name))
 	}
+	return f.hash, nil
+}
 
-	fis, ok := _escDirs[f.local]
-	if !ok {
-		return nil, fmt.Errorf(" escFile.Readdir: '%s' is directory, but we have no info about content of this dir, local=%s", f.name, f.local)
+// {{.FunctionPrefix}}FSContentType returns the MIME type of the embedded file name, e.g.
+// "text/css; charset=utf-8", detected from its extension or else its content
+// when it was embedded.
+func {{.FunctionPrefix}}FSContentType(name string) (string, error) {
+	f, _, present := _escLookup(name)
+	if !present {
+		return "", os.ErrNotExist
 	}
-	limit := count
-	if count <= 0 || limit > len(fis) {
-		limit = len(fis)
+	if f.contentType == "" {
+		return "", fmt.Errorf("esc: no content type for %s", path.Clean(name))
 	}
+	return f.contentType, nil
+}
 
-	if len(fis) == 0 && count > 0 {
-		return nil, io.EOF
+// {{.FunctionPrefix}}FSVersionedPath returns name with its {{.FunctionPrefix}}FSVersion as "v" query parameter,
+// e.g. "/app.js?v=ab12cd34". If name has no version, it is returned unchanged.
+func {{.FunctionPrefix}}FSVersionedPath(name string) string {
+	v, err := {{.FunctionPrefix}}FSVersion(name)
+	if err != nil {
+		return name
 	}
+	return name + "?v=" + v
+}
 
-	return fis[0:limit], nil
+// {{.FunctionPrefix}}FSRelPath returns the relative URL path from the page or directory from to
+// the asset to, e.g. "../css/main.css" from "/blog/post.html" to
+// "/css/main.css", so links work wherever the assets are mounted. Like a URL,
+// from is a directory only with a trailing slash, and to keeps its trailing
+// slash. Both must be embedded.
+func {{.FunctionPrefix}}FSRelPath(from, to string) (string, error) {
+	for _, name := range []string{from, to} {
+		if _, _, present := _escLookup(name); !present {
+			return "", &os.PathError{Op: "relpath", Path: name, Err: os.ErrNotExist}
+		}
+	}
+	dir := path.Clean("/" + from)
+	if !strings.HasSuffix(from, "/") {
+		dir = path.Dir(dir)
+	}
+	target := path.Clean("/" + to)
+	fromParts, toParts := _escSplitPath(dir), _escSplitPath(target)
+	i := 0
+	for i < len(fromParts) && i < len(toParts) && fromParts[i] == toParts[i] {
+		i++
+	}
+	up := len(fromParts) - i
+	rest := toParts[i:]
+	if len(rest) == 0 && target != "/" && !strings.HasSuffix(to, "/") {
+		// to is an ancestor of dir named without a trailing slash, which must
+		// be referred to by name from its parent.
+		up++
+		rest = toParts[len(toParts)-1:]
+	}
+	rel := strings.Repeat("../", up) + strings.Join(rest, "/")
+	switch {
+	case rel == "":
+		return "./", nil
+	case len(rest) > 0 && strings.HasSuffix(to, "/"):
+		rel += "/"
+	case up == 0 && strings.Contains(rest[0], ":"):
+		// A colon in the first segment would be read as a URL scheme.
+		rel = "./" + rel
+	}
+	return rel, nil
 }
 
+// {{.FunctionPrefix}}FSGlob returns the sorted canonical names of the embedded files and
+// directories matching pattern, e.g. "/migrations/*.sql". Path elements are
+// matched with path.Match, except for ** which matches any number of them, as
+// in "/migrations/**/*.sql". It returns nil if pattern is malformed.
+func {{.FunctionPrefix}}FSGlob(pattern string) []string {
+	elems := _escSplitPath(path.Clean("/" + pattern))
+	for _, elem := range elems {
+		if _, err := path.Match(elem, ""); err != nil {
+			return nil
+		}
+	}
+	var names []string
+	{{- if .BinarySearch}}
+	for i := range _escEntries {
+		name := _escName(i)
+	{{- else}}
+	for name := range _escData {
+	{{- end}}
+		if _escGlobMatch(elems, _escSplitPath(name)) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
 
-func (f *_escFile) Stat() (os.FileInfo, error) {
-	return f, nil
+// {{.FunctionPrefix}}FSNames returns the sorted canonical names of all embedded files, e.g.
+// "/css/main.css". Each call returns a new slice.
+func {{.FunctionPrefix}}FSNames() []string {
+	return _escListNames(false)
 }
 
-func (f *_escFile) Name() string {
-	return f.name
+// {{.FunctionPrefix}}FSDirNames returns the sorted canonical names of all embedded
+// directories, e.g. "/css". Each call returns a new slice.
+func {{.FunctionPrefix}}FSDirNames() []string {
+	return _escListNames(true)
 }
+{{- if not .MetadataOnly}}
 
-func (f *_escFile) Size() int64 {
-	return f.size
+// {{.FunctionPrefix}}FSPreloadAll decompresses all embedded files up front, e.g. at startup of
+// a latency sensitive server, instead of on their first read. It returns the
+// first error.
+func {{.FunctionPrefix}}FSPreloadAll() error {
+	for _, name := range {{.FunctionPrefix}}FSNames() {
+		if _, err := _escStatic.prepare(name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
+{{- end}}
 
-func (f *_escFile) Mode() os.FileMode {
-	return 0
+// _escListNames returns the sorted names of the embedded directories if dirs,
+// else of the embedded files.
+func _escListNames(dirs bool) []string {
+	var names []string
+	{{- if .BinarySearch}}
+	lo, hi := 0, _escFileCount
+	if dirs {
+		lo, hi = _escFileCount, len(_escEntries)
+	}
+	for i := lo; i < hi; i++ {
+		names = append(names, _escName(i))
+	}
+	{{- else}}
+	for name, f := range _escData {
+		if f.isDir == dirs {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	{{- end}}
+	return names
 }
 
-func (f *_escFile) ModTime() time.Time {
-	return time.Unix(f.modtime, 0)
+// _escGlobMatch reports whether the path elements of a name match those of a
+// pattern, where ** matches any number of elements.
+func _escGlobMatch(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if _escGlobMatch(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
 }
 
-func (f *_escFile) IsDir() bool {
-	return f.isDir
+// _escSplitPath returns the elements of the clean absolute path name.
+func _escSplitPath(name string) []string {
+	if name == "/" {
+		return nil
+	}
+	return strings.Split(name[1:], "/")
 }
 
-func (f *_escFile) Sys() interface{} {
-	return f
+// {{.FunctionPrefix}}FSHandlerOptions configures the handler returned by {{.FunctionPrefix}}FSHandler.
+type {{.FunctionPrefix}}FSHandlerOptions struct {
+	// ImmutableCacheControl is the Cache-Control header for fingerprinted
+	// names. It defaults to "public, max-age=31536000, immutable".
+	ImmutableCacheControl string
+	// CacheControl is the Cache-Control header for all other names. It
+	// defaults to "no-cache".
+	CacheControl string
+	// Fallback, if set, is the file, e.g. "/index.html", served for GET and
+	// HEAD requests of paths without extension naming no file or directory,
+	// which single-page apps route in the browser. Paths with an extension
+	// name missing assets and are not found as usual.
+	Fallback string
+	// FallbackExclude holds the path prefixes, e.g. "/api", under which
+	// missing paths are not found instead of served Fallback. It defaults to
+	// "/api".
+	FallbackExclude []string
+	// DisableListing, if true, responds 404 Not Found for directories
+	// without index.html instead of listing them like http.FileServer.
+	DisableListing bool
 }
 
-// {{.FunctionPrefix}}FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func {{.FunctionPrefix}}FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _escLocal
+// {{.FunctionPrefix}}FSHandler returns an http.Handler serving the embedded assets like
+// http.FileServer. Fingerprinted names are served as immutable, while their
+// canonical names must be revalidated. Files are served with their
+// {{.FunctionPrefix}}FSHash as strong ETag. If useLocal is true, the filesystem's contents
+// are instead used, without ETags, and fingerprinted names of files whose
+// content changed since generation are not found.
+func {{.FunctionPrefix}}FSHandler(useLocal bool, opts {{.FunctionPrefix}}FSHandlerOptions) http.Handler {
+	{{- with .ForceLocal}}
+	useLocal = {{.}}
+	{{- end}}
+	fs := {{.FunctionPrefix}}FS(useLocal)
+	fileServer := http.FileServer(fs)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Clean("/" + r.URL.Path)
+		if _escUseFallback(fs, name, r, opts) {
+			_escServeFallback(w, r, fs, useLocal, opts)
+			return
+		}
+		if opts.DisableListing && _escListed(fs, name) {
+			http.NotFound(w, r)
+			return
+		}
+		if _, fingerprinted := _escFingerprints[name]; fingerprinted {
+			f, err := fs.Open(name)
+			if err != nil {
+				http.NotFound(w, r)
+				return
+			}
+			f.Close()
+		}
+		w.Header().Set("Cache-Control", _escCacheControl(name, opts))
+		if hash, err := {{.FunctionPrefix}}FSHash(name); err == nil && !useLocal {
+			w.Header().Set("ETag", ` + "`" + `"` + "`" + `+hash+` + "`" + `"` + "`" + `)
+		}
+		if ctype, err := {{.FunctionPrefix}}FSContentType(name); err == nil && !useLocal {
+			w.Header().Set("Content-Type", ctype)
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+}
+
+// _escListed reports whether name is a directory of fs without index.html,
+// which http.FileServer lists.
+func _escListed(fs http.FileSystem, name string) bool {
+	f, err := fs.Open(name)
+	if err != nil {
+		return false
 	}
-	return _escStatic
+	fi, err := f.Stat()
+	f.Close()
+	if err != nil || !fi.IsDir() {
+		return false
+	}
+	index, err := fs.Open(path.Join(name, "index.html"))
+	if err != nil {
+		return true
+	}
+	index.Close()
+	return false
 }
 
-// {{.FunctionPrefix}}Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
-// If useLocal is true, the filesystem's contents are instead used.
-func {{.FunctionPrefix}}Dir(useLocal bool, name string) http.FileSystem {
-	if useLocal {
-		return _escDirectory{fs: _escLocal, name: name}
+// _escUseFallback reports whether r for name is served opts.Fallback.
+func _escUseFallback(fs http.FileSystem, name string, r *http.Request, opts {{.FunctionPrefix}}FSHandlerOptions) bool {
+	if opts.Fallback == "" || r.Method != http.MethodGet && r.Method != http.MethodHead || path.Ext(name) != "" {
+		return false
 	}
-	return _escDirectory{fs: _escStatic, name: name}
+	exclude := opts.FallbackExclude
+	if exclude == nil {
+		exclude = []string{"/api"}
+	}
+	for _, prefix := range exclude {
+		prefix = path.Clean("/" + prefix)
+		if name == prefix || strings.HasPrefix(name, strings.TrimSuffix(prefix, "/")+"/") {
+			return false
+		}
+	}
+	f, err := fs.Open(name)
+	if err == nil {
+		f.Close()
+	}
+	return os.IsNotExist(err)
 }
 
-// {{.FunctionPrefix}}FSByte returns the named file from the embedded assets. If useLocal is
+// _escServeFallback responds to r with opts.Fallback from fs, which unlike
+// http.FileServer does not redirect a name ending in /index.html.
+func _escServeFallback(w http.ResponseWriter, r *http.Request, fs http.FileSystem, useLocal bool, opts {{.FunctionPrefix}}FSHandlerOptions) {
+	name := path.Clean("/" + opts.Fallback)
+	f, err := fs.Open(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Cache-Control", _escCacheControl(name, opts))
+	if hash, err := {{.FunctionPrefix}}FSHash(name); err == nil && !useLocal {
+		w.Header().Set("ETag", ` + "`" + `"` + "`" + `+hash+` + "`" + `"` + "`" + `)
+	}
+	if ctype, err := {{.FunctionPrefix}}FSContentType(name); err == nil && !useLocal {
+		w.Header().Set("Content-Type", ctype)
+	}
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+// {{.FunctionPrefix}}FSServeFile responds to r with the embedded file name, e.g. "/favicon.ico",
+// using http.ServeContent, which sets Content-Type, Content-Length and
+// Last-Modified and handles conditional and range requests. The file's
+// {{.FunctionPrefix}}FSHash is its ETag. It responds with 404 Not Found if name is not an
+// embedded file.
+func {{.FunctionPrefix}}FSServeFile(w http.ResponseWriter, r *http.Request, name string) {
+	f, err := {{.FunctionPrefix}}FS(false).Open(name)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if fi.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+	if hash, err := {{.FunctionPrefix}}FSHash(name); err == nil {
+		w.Header().Set("ETag", ` + "`" + `"` + "`" + `+hash+` + "`" + `"` + "`" + `)
+	}
+	if ctype, err := {{.FunctionPrefix}}FSContentType(name); err == nil {
+		w.Header().Set("Content-Type", ctype)
+	}
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+// _escCacheControl returns the Cache-Control header for name as configured by
+// opts.
+func _escCacheControl(name string, opts {{.FunctionPrefix}}FSHandlerOptions) string {
+	if _, fingerprinted := _escFingerprints[name]; fingerprinted {
+		if opts.ImmutableCacheControl == "" {
+			return "public, max-age=31536000, immutable"
+		}
+		return opts.ImmutableCacheControl
+	}
+	if opts.CacheControl == "" {
+		return "no-cache"
+	}
+	return opts.CacheControl
+}
+
+// {{.FunctionPrefix}}FSGzipHandler returns an http.Handler serving the embedded assets like
+// {{.FunctionPrefix}}FSHandler, except that files embedded compressed are served as their {{if .Compression}}compressed{{else}}gzip{{end}}
+// data with Content-Encoding {{.Coding}} to clients accepting it, without
+// decompressing them. Files with a brotli variant, see the
+// -precompressed-brotli flag of esc, are served as that to clients accepting
+// brotli. Only clients accepting neither, files embedded uncompressed and
+// files without {{.FunctionPrefix}}FSContentType are served by {{.FunctionPrefix}}FSHandler.
+func {{.FunctionPrefix}}FSGzipHandler(opts {{.FunctionPrefix}}FSHandlerOptions) http.Handler {
+	handler := {{.FunctionPrefix}}FSHandler(false, opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Clean("/" + r.URL.Path)
+		f, _, present := _escLookup(name)
+		if !present || f.isDir || f.compressed == ""{{if .Brotli}} && f.br == ""{{end}} {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		var content io.ReadSeeker
+		var coding string
+		switch {
+		case f.contentType == "":
+		{{- if .Brotli}}
+		case f.br != "" && _escAccepts(r, "br"):
+			content, coding = strings.NewReader(f.br), "br"
+		{{- end}}
+		case f.compressed != "" && _escAccepts(r, "{{.Coding}}"):
+			gz, err := _escGzip(f)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			content, coding = bytes.NewReader(gz), "{{.Coding}}"
+		}
+		if content == nil {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		h := w.Header()
+		h.Set("Cache-Control", _escCacheControl(name, opts))
+		h.Set("Content-Type", f.contentType)
+		h.Set("Content-Encoding", coding)
+		if f.hash != "" {
+			// Every encoding is another representation of the content.
+			h.Set("ETag", ` + "`" + `"` + "`" + `+f.hash+"."+coding+` + "`" + `"` + "`" + `)
+		}
+		http.ServeContent(w, r, name, f.ModTime(), content)
+	})
+}
+
+// _escAccepts reports whether the Accept-Encoding headers of r accept
+// coding with a non-zero quality. Coding itself takes precedence over the
+// wildcard *, whatever their order.
+func _escAccepts(r *http.Request, coding string) bool {
+	explicit, wildcard := -1.0, -1.0
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, c := range strings.Split(header, ",") {
+			params := strings.Split(c, ";")
+			q := 1.0
+			for _, param := range params[1:] {
+				param = strings.TrimSpace(param)
+				if len(param) < 2 || !strings.EqualFold(param[:2], "q=") {
+					continue
+				}
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+			switch name := strings.TrimSpace(params[0]); {
+			case strings.EqualFold(name, coding):
+				if q > explicit {
+					explicit = q
+				}
+			case name == "*":
+				if q > wildcard {
+					wildcard = q
+				}
+			}
+		}
+	}
+	if explicit >= 0 {
+		return explicit > 0
+	}
+	return wildcard > 0
+}
+
+// {{.FunctionPrefix}}FSNode is a file or directory in the tree returned by {{.FunctionPrefix}}FSTree.
+type {{.FunctionPrefix}}FSNode struct {
+	// Name is the canonical name, e.g. "/css/main.css".
+	Name  string
+	IsDir bool
+	// Size is the uncompressed size of a file; zero for directories.
+	Size int64
+	// ModTime is the Unix timestamp of a file; zero for directories.
+	ModTime  int64
+	Children []*{{.FunctionPrefix}}FSNode
+}
+
+type _escFSNodes = []*{{.FunctionPrefix}}FSNode
+
+{{- with .PathConstants}}
+
+// Canonical names of the embedded files.
+const (
+{{- range .}}
+	{{.Ident}} = {{printf "%q" .Name}}
+{{- end}}
+)
+{{- end}}
+
+// {{.FunctionPrefix}}FSTree returns the embedded assets as a tree rooted at "/", with children
+// sorted by name. Each call returns a new tree.
+func {{.FunctionPrefix}}FSTree() *{{.FunctionPrefix}}FSNode {
+	return &{{.FunctionPrefix}}FSNode{{template "escNode" .Tree}}
+}
+{{- if .Interface}}
+
+// {{.FunctionPrefix}}FSAssets is the access to the assets of this package, implemented by
+// {{.FunctionPrefix}}FSInstance for the embedded assets and by {{.FunctionPrefix}}NewFSFake for tests.
+type {{.FunctionPrefix}}FSAssets interface {
+	// Open opens the named asset like http.FileSystem.
+	Open(name string) (http.File, error)
+	// Byte returns the content of the named asset.
+	Byte(name string) ([]byte, error)
+	// String returns the content of the named asset.
+	String(name string) (string, error)
+	// Names returns the names of all files, sorted.
+	Names() []string
+	// ModTime returns the modification time of the named asset.
+	ModTime(name string) (time.Time, error)
+	// Handler returns a handler serving the assets.
+	Handler() http.Handler
+}
+
+var _ {{.FunctionPrefix}}FSAssets = _escAssets{}
+
+// {{.FunctionPrefix}}FSInstance returns the embedded assets as {{.FunctionPrefix}}FSAssets. If useLocal is
 // true, the filesystem's contents are instead used.
-func {{.FunctionPrefix}}FSByte(useLocal bool, name string) ([]byte, error) {
-	if useLocal {
-		f, err := _escLocal.Open(name)
-		if err != nil {
-			return nil, err
+func {{.FunctionPrefix}}FSInstance(useLocal bool) {{.FunctionPrefix}}FSAssets {
+	return _escAssets{useLocal: useLocal}
+}
+
+type _escAssets struct {
+	useLocal bool
+}
+
+func (a _escAssets) Open(name string) (http.File, error) {
+	return {{.FunctionPrefix}}FS(a.useLocal).Open(name)
+}
+
+func (a _escAssets) Byte(name string) ([]byte, error) {
+	return {{.FunctionPrefix}}FSByte(a.useLocal, name)
+}
+
+func (a _escAssets) String(name string) (string, error) {
+	return {{.FunctionPrefix}}FSString(a.useLocal, name)
+}
+
+func (a _escAssets) Names() []string {
+	var names []string
+	var walk func(n *{{.FunctionPrefix}}FSNode)
+	walk = func(n *{{.FunctionPrefix}}FSNode) {
+		if !n.IsDir {
+			names = append(names, n.Name)
+		}
+		for _, c := range n.Children {
+			walk(c)
 		}
-		b, err := ioutil.ReadAll(f)
-		_ = f.Close()
-		return b, err
 	}
-	f, err := _escStatic.prepare(name)
+	walk({{.FunctionPrefix}}FSTree())
+	sort.Strings(names)
+	return names
+}
+
+func (a _escAssets) ModTime(name string) (time.Time, error) {
+	f, err := a.Open(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
+func (a _escAssets) Handler() http.Handler {
+	return {{.FunctionPrefix}}FSHandler(a.useLocal, {{.FunctionPrefix}}FSHandlerOptions{})
+}
+
+// {{.FunctionPrefix}}NewFSFake returns {{.FunctionPrefix}}FSAssets holding files in memory, by name, e.g.
+// "/css/main.css", with the directories containing them. It is meant for
+// tests of code using {{.FunctionPrefix}}FSAssets, which do not need the embedded assets.
+func {{.FunctionPrefix}}NewFSFake(files map[string][]byte) {{.FunctionPrefix}}FSAssets {
+	m := make(fstest.MapFS, len(files))
+	for name, b := range files {
+		m[strings.TrimPrefix(path.Clean("/"+name), "/")] = &fstest.MapFile{Data: b, Mode: 0444}
+	}
+	return _escFake{m}
+}
+
+type _escFake struct {
+	files fstest.MapFS
+}
+
+func (f _escFake) Open(name string) (http.File, error) {
+	return http.FS(f.files).Open(name)
+}
+
+// file returns the named file, which must not be a directory.
+func (f _escFake) file(name string) (*fstest.MapFile, error) {
+	if mf, ok := f.files[strings.TrimPrefix(path.Clean("/"+name), "/")]; ok {
+		return mf, nil
+	}
+	return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+}
+
+func (f _escFake) Byte(name string) ([]byte, error) {
+	mf, err := f.file(name)
 	if err != nil {
 		return nil, err
 	}
-	return f.data, nil
+	return append([]byte(nil), mf.Data...), nil
 }
 
-// {{.FunctionPrefix}}FSMustByte is the same as {{.FunctionPrefix}}FSByte, but panics if name is not present.
-func {{.FunctionPrefix}}FSMustByte(useLocal bool, name string) []byte {
-	b, err := {{.FunctionPrefix}}FSByte(useLocal, name)
+func (f _escFake) String(name string) (string, error) {
+	mf, err := f.file(name)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return b
+	return string(mf.Data), nil
 }
 
-// {{.FunctionPrefix}}FSString is the string version of {{.FunctionPrefix}}FSByte.
-func {{.FunctionPrefix}}FSString(useLocal bool, name string) (string, error) {
-	b, err := {{.FunctionPrefix}}FSByte(useLocal, name)
-	return string(b), err
+func (f _escFake) Names() []string {
+	names := make([]string, 0, len(f.files))
+	for name := range f.files {
+		names = append(names, "/"+name)
+	}
+	sort.Strings(names)
+	return names
 }
 
-// {{.FunctionPrefix}}FSMustString is the string version of {{.FunctionPrefix}}FSMustByte.
-func {{.FunctionPrefix}}FSMustString(useLocal bool, name string) string {
-	return string({{.FunctionPrefix}}FSMustByte(useLocal, name))
+func (f _escFake) ModTime(name string) (time.Time, error) {
+	mf, err := f.file(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return mf.ModTime, nil
+}
+
+func (f _escFake) Handler() http.Handler {
+	return http.FileServer(http.FS(f.files))
+}
+{{- end}}
+
+{{define "escNode"}}{
+	Name: {{printf "%q" .Name}}, IsDir: {{.IsDir}}, Size: {{.Size}}, ModTime: {{.ModTime}},
+	{{- with .Children}}
+	Children: _escFSNodes{
+	{{- range .}}
+		{{template "escNode" .}},
+	{{- end}}
+	},
+	{{- end}}
+}{{end -}}
+
+{{with .Blobs.List -}}
+// _escBlob constants hold contents embedded for several files.
+const (
+{{- range .}}
+	{{.Name}} = {{if .Raw}}{{literal .File.Data}}{{else if $.StringEncoding}}{{literal .File.GzipData}}{{else}}` + "`" + `{{printf "\n\x01%d\n" (index $.EntryIndex .File.Name)}}` + "`" + `{{end}}
+{{- end}}
+)
+
+{{end -}}
+{{with .Packed -}}
+{{if $.SidecarFile -}}
+// _escPacked holds the data of all files, which each slice it, written to
+// {{$.SidecarFile}} by esc.
+//
+//go:embed {{$.SidecarFile}}
+var _escPacked string
+{{- else -}}
+// _escPacked holds the gzip data of all files, which each slice it.
+const _escPacked = {{literal .Blob}}
+{{- end}}
+
+{{end -}}
+{{if .BinarySearch -}}
+// _escFileCount is the number of files, which precede the directories in
+// _escEntries.
+const _escFileCount = {{len .Files}}
+{{with .Compact}}
+// _escNameBlob holds the names of _escEntries, files and then directories
+// each sorted by name, with the name of the i-th entry from
+// _escNameOffsets[i] to _escNameOffsets[i+1].
+const _escNameBlob = {{printf "%q" .NameBlob}}
+
+var _escNameOffsets = [...]uint32{
+{{- range .NameOffsets}}{{.}}, {{end -}}
 }
 
+// _escLocalBlob holds the local paths of _escEntries.
+const _escLocalBlob = {{printf "%q" .LocalBlob}}
+{{else}}
+// _escNames holds the names of _escEntries, files and then directories each
+// sorted by name.
+var _escNames = []string{
+{{- range .Files}}
+	"{{.Name}}",
+{{- end}}
+{{- range .Dirs}}
+	"{{.Name}}",
+{{- end}}
+}
+{{end}}
+var _escEntries = []*_escFile{
+{{- else -}}
 var _escData = map[string]*_escFile{
+{{- end}}
 {{ range .Files }}
-	"{{ .Name }}": {
+	{{if not $.BinarySearch}}"{{ .Name }}": {{end}}{
 		name:    "{{ .BaseName }}",
+		{{- if $.Compact}}
+		local:   _escLocalBlob[{{index $.Compact.LocalSpans .Name}}],
+		{{- else}}
 		local:   "{{ .Local }}",
-		size:    {{ .Data | len  }},
+		{{- end}}
+		size:    {{ .Size }},
 		modtime: {{ .ModTime }},
-		compressed: ` + "`" + `{{ .Compressed }}` + "`" + `,
+		{{- with .Mode}}
+		mode:    {{printf "%#o" .}},
+		{{- end}}
+		{{- with .Version}}
+		version: "{{.}}",
+		{{- end}}
+		{{- if and .SHA256 (not (and $.DevVariant .Local (not .Archive)))}}
+		hash:    "{{.SHA256}}",
+		{{- end}}
+		{{- with .ContentType}}
+		contentType: "{{.}}",
+		{{- end}}
+		{{- with .Fingerprint}}
+		fingerprint: "{{.}}",
+		{{- end}}
+		{{- if and .EmbedPath (not $.DevVariant)}}
+		embed: "{{.EmbedPath}}",
+		{{- end}}
+		{{- with .Archive}}
+		archive: "{{.}}",
+		{{- end}}
+		{{- if $.DevVariant}}
+		{{- if or (not .Local) .Archive}}
+		raw: {{literal .Data}},
+		{{- end}}
+		{{- else}}
+		{{- if not (or $.MetadataOnly $.WrapEmbedVar .Stored)}}
+		{{- if index $.Blobs.Compressed .Name}}
+		compressed: {{index $.Blobs.Compressed .Name}},
+		{{- else if $.Packed}}
+		compressed: _escPacked[{{index $.Packed.Spans .Name}}],
+		{{- else if $.Sharded}}
+		compressed: _escCompressed{{index $.EntryIndex .Name}},
+		{{- else if $.StringEncoding}}
+		compressed: {{literal .GzipData}},
+		{{- else}}
+		compressed: ` + "`" + `{{printf "\n\x01%d\n" (index $.EntryIndex .Name)}}` + "`" + `,
+		{{- end}}
+		{{- end}}
+		{{- if and (or .Dual .Stored) (index $.Blobs.Raw .Name)}}
+		raw: {{index $.Blobs.Raw .Name}},
+		{{- else if and (or .Dual .Stored) $.Packed (index $.Packed.RawSpans .Name)}}
+		raw: _escPacked[{{index $.Packed.RawSpans .Name}}],
+		{{- else if and (or .Dual .Stored) $.Sharded}}
+		raw: _escRaw{{index $.EntryIndex .Name}},
+		{{- else if or .Dual .Stored}}
+		raw: {{literal .Data}},
+		{{- end}}
+		{{- if and .Brotli $.Packed (index $.Packed.BrotliSpans .Name)}}
+		br: _escPacked[{{index $.Packed.BrotliSpans .Name}}],
+		{{- else if and .Brotli $.Sharded}}
+		br: _escBrotli{{index $.EntryIndex .Name}},
+		{{- else if .Brotli}}
+		br: {{literal .Brotli}},
+		{{- end}}
+		{{- end}}
 	},
 {{ end -}}
 {{ range .Dirs }}
-	"{{ .Name }}": {
+	{{if not $.BinarySearch}}"{{ .Name }}": {{end}}{
 		name:  "{{ .BaseName }}",
+		{{- if $.Compact}}
+		local: _escLocalBlob[{{index $.Compact.LocalSpans .Name}}],
+		{{- else}}
 		local: ` + "`" + `{{ .Local }}` + "`" + `,
+		{{- end}}
 		isDir: true,
+		{{- with .Archive}}
+		archive: "{{.}}",
+		{{- end}}
 	},
   {{ end }}
 }
 
+// _escFingerprints maps fingerprinted names to their canonical names.
+var _escFingerprints = map[string]string{
+{{- range .Files}}{{if .Fingerprint}}
+	"{{.Fingerprint}}": "{{.Name}}",
+{{- end}}{{end}}
+}
+{{- with .Folded}}
+
+// _escFolded maps the lower case of the embedded names to the names.
+var _escFolded = map[string]string{
+{{- range .}}
+	"{{.Folded}}": "{{.Name}}",
+{{- end}}
+}
+{{- end}}
+{{- with .NFC}}
+
+// _escDecompositions maps the runes decomposing into runes of the embedded
+// names in normalization form D to their decomposition, see _escNFC.
+var _escDecompositions = map[rune][]rune{
+{{- range .Decompositions}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+
+// _escCombiningClasses maps the combining marks of the embedded names in
+// normalization form D to their canonical combining class.
+var _escCombiningClasses = map[rune]uint8{
+{{- range .CombiningClasses}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+
+// _escCompositions maps the rune pairs composing the embedded names to their
+// composition.
+var _escCompositions = map[[2]rune]rune{
+{{- range .Compositions}}
+	{{.Key}}: {{.Value}},
+{{- end}}
+}
+{{- end}}
+
 var _escDirs = map[string][]os.FileInfo{
   {{ range .Dirs }}
 	"{{ .Local }}": {
 		{{ range .ChildFileNames -}}
-		_escData["{{.}}"],
+		{{if $.BinarySearch}}_escEntries[{{index $.EntryIndex .}}]{{else}}_escData["{{.}}"]{{end}},
 		{{ end }}
 	},
   {{ end }}
@@ -0,0 +1,118 @@
+package embed
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Encodings for Config.Encoding.
+const (
+	// EncodingBase64 embeds the gzip data of files as base64 text in raw
+	// string literals, which keeps the output readable.
+	EncodingBase64 = "base64"
+	// EncodingString embeds the gzip data of files as quoted string
+	// literals, which saves decoding base64 and makes the binary smaller by
+	// a quarter of the data, but the output larger.
+	EncodingString = "string"
+	// EncodingPacked is EncodingString with the gzip data of all files in
+	// one string, which files slice by offset, so the output has a single
+	// data literal and compiles faster.
+	EncodingPacked = "packed"
+	// EncodingSidecar is EncodingPacked with the string, which also holds
+	// the data of files embedded uncompressed and their brotli variants,
+	// written to a .bin file next to the output file and embedded with
+	// go:embed, so the output holds only the index of the files.
+	EncodingSidecar = "sidecar"
+)
+
+// checkEncoding returns an error if the encoding of conf is not an encoding
+// or cannot be used with the rest of conf.
+func checkEncoding(conf *Config) error {
+	switch conf.Encoding {
+	case "", EncodingBase64, EncodingString, EncodingPacked:
+		return nil
+	case EncodingSidecar:
+		return checkSidecar(conf)
+	}
+	return fmt.Errorf("unknown encoding %q, want %s, %s, %s or %s", conf.Encoding, EncodingBase64, EncodingString, EncodingPacked, EncodingSidecar)
+}
+
+// checkSidecar validates the Config of an EncodingSidecar output.
+func checkSidecar(conf *Config) error {
+	switch {
+	case conf.OutputFile == "":
+		return errors.New("the sidecar encoding requires an output file to write the data file next to")
+	case conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed:
+		return errors.New("the sidecar encoding requires embedded file contents")
+	}
+	if base := filepath.Base(sidecarFileName(conf.OutputFile)); strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") {
+		return fmt.Errorf("data file %s cannot be embedded with go:embed", base)
+	}
+	return nil
+}
+
+// sidecarFileName returns the name of the data file written next to the
+// output file with EncodingSidecar, e.g. "static.bin" for "static.go".
+func sidecarFileName(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + ".bin"
+}
+
+// quoted reports whether encoding writes gzip data as quoted strings, or
+// as is.
+func quoted(encoding string) bool {
+	return encoding == EncodingString || encoding == EncodingPacked || encoding == EncodingSidecar
+}
+
+// packedLayout holds the data of the files of a Plan concatenated for
+// EncodingPacked and EncodingSidecar.
+type packedLayout struct {
+	// Blob holds the data, Spans maps names to the slice expression bounds
+	// of their gzip data in it, e.g. "10:24", and RawSpans and BrotliSpans
+	// those of their content and brotli variant. The same data is held
+	// once.
+	Blob                  string
+	Spans                 map[string]string
+	RawSpans, BrotliSpans map[string]string
+}
+
+// packedLayout returns the packed layout of p, or nil if p embeds no gzip
+// data. With all, it also holds the contents embedded uncompressed and the
+// brotli variants, and is never nil.
+func (p *Plan) packedLayout(all bool) *packedLayout {
+	var blob strings.Builder
+	l := &packedLayout{
+		Spans:       make(map[string]string, len(p.files)),
+		RawSpans:    make(map[string]string),
+		BrotliSpans: make(map[string]string),
+	}
+	spans := make(map[string]string)
+	add := func(m map[string]string, name string, b []byte) {
+		data := string(b)
+		span, ok := spans[data]
+		if !ok {
+			start := blob.Len()
+			blob.WriteString(data)
+			span = fmt.Sprintf("%d:%d", start, blob.Len())
+			spans[data] = span
+		}
+		m[name] = span
+	}
+	for _, f := range p.files {
+		if !f.Stored {
+			add(l.Spans, f.Name, f.GzipData)
+		}
+		if all && (f.Dual || f.Stored) {
+			add(l.RawSpans, f.Name, f.Data)
+		}
+		if all && f.Brotli != nil {
+			add(l.BrotliSpans, f.Name, f.Brotli)
+		}
+	}
+	if blob.Len() == 0 && !all {
+		return nil
+	}
+	l.Blob = blob.String()
+	return l
+}
@@ -0,0 +1,56 @@
+package embed
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+)
+
+// checkEncryption validates the Config of encrypted output.
+func checkEncryption(conf *Config) error {
+	if len(conf.EncryptionKey) == 0 {
+		if conf.EncryptionKeyEnv != "" {
+			return errors.New("EncryptionKeyEnv requires an EncryptionKey")
+		}
+		return nil
+	}
+	switch {
+	case len(conf.EncryptionKey) != 16 && len(conf.EncryptionKey) != 24 && len(conf.EncryptionKey) != 32:
+		return errors.Errorf("encryption key of %d bytes, want 16, 24 or 32", len(conf.EncryptionKey))
+	case conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed:
+		return errors.New("encryption requires embedded file contents")
+	case conf.ZeroCopy || len(conf.DualStorage) > 0:
+		return errors.New("encryption embeds no file uncompressed")
+	case conf.PrecompressedBrotli:
+		return errors.New("encryption covers no precompressed variants")
+	}
+	return nil
+}
+
+// encryptFiles replaces the gzip data of files with its AES-GCM encryption
+// with key, prefixed by the nonce. The nonce is derived from the gzip data,
+// so the output stays reproducible and the same data is never encrypted
+// with the same nonce twice.
+func encryptFiles(files []*_escFile, key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return errors.Wrap(err, "encryption key")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return errors.Wrap(err, "encryption key")
+	}
+	for _, f := range files {
+		if f.GzipData == nil {
+			continue
+		}
+		mac := hmac.New(sha256.New, key)
+		mac.Write(f.GzipData)
+		nonce := mac.Sum(nil)[:aead.NonceSize()]
+		f.setGzip(aead.Seal(nonce, nonce, f.GzipData, nil))
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package embed
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEncryption(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"a.txt": "licensed font a",
+		"b.txt": "licensed font b",
+	})
+	key := bytes.Repeat([]byte{7}, 32)
+	conf := &Config{
+		Package:          "main",
+		Files:            []string{root},
+		Prefix:           root,
+		Encoding:         EncodingString,
+		NoCompression:    true,
+		EncryptionKey:    key,
+		EncryptionKeyEnv: "ESC_TEST_KEY",
+	}
+	var out bytes.Buffer
+	if err := Run(conf, &out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "licensed font") {
+		t.Fatal("encrypted output holds the contents of a file")
+	}
+	sources := map[string]string{"key_test.go": `package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	if _, err := FSByte(false, "/a.txt"); err == nil || !strings.Contains(err.Error(), "no key") {
+		t.Fatalf("FSByte() without key error = %v", err)
+	}
+	os.Setenv("ESC_TEST_KEY", "` + hex.EncodeToString(key) + `")
+	if b, err := FSByte(false, "/b.txt"); err != nil || string(b) != "licensed font b" {
+		t.Fatalf("FSByte() with key in $ESC_TEST_KEY = %q, %v", b, err)
+	}
+	gz, err := FSGzipByte("/b.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadAll(gr); err != nil || string(b) != "licensed font b" {
+		t.Fatalf("FSGzipByte() decompressed = %q, %v", b, err)
+	}
+	if err := FSSetKey(make([]byte, 32)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := FSByte(false, "/a.txt"); err == nil || !strings.Contains(err.Error(), "decrypt") {
+			t.Fatalf("FSByte() with wrong key error = %v", err)
+		}
+	}
+	if err := FSSetKey([]byte("short")); err == nil {
+		t.Fatal("FSSetKey() accepted a key of 5 bytes")
+	}
+}
+`}
+	if out := runGenerated(t, conf, sources, "test", "-v", "."); !strings.Contains(out, "--- PASS: TestKey") {
+		t.Errorf("go test:\n%s", out)
+	}
+
+	for _, bad := range []*Config{
+		{Package: "main", EncryptionKey: key[:10]},
+		{Package: "main", EncryptionKey: key, ZeroCopy: true},
+		{Package: "main", EncryptionKey: key, MetadataOnly: true},
+		{Package: "main", EncryptionKeyEnv: "ESC_TEST_KEY"},
+	} {
+		if _, err := Collect(bad); err == nil {
+			t.Errorf("Collect(%+v) accepted the encryption config", bad)
+		}
+	}
+}
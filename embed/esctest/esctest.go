@@ -0,0 +1,201 @@
+// Package esctest checks that an http.FileSystem serves the assets of an
+// esc generated file correctly. It is meant for code wrapping the generated
+// FS, which can then verify itself against the generated manifest with:
+//
+//	esctest.TestFileSystem(t, wrapped, _escManifest)
+//
+// The manifest is written by esc when run with -conformance.
+package esctest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Entry describes an embedded file or directory.
+type Entry struct {
+	// Name is the canonical name, e.g. "/css/main.css".
+	Name  string
+	IsDir bool
+	// Size is the uncompressed size of a file.
+	Size int64
+	// ModTime is the Unix timestamp of a file. It is only checked if non-zero.
+	ModTime int64
+	// SHA256 is the hex encoded SHA-256 digest of the content of a file.
+	SHA256 string
+}
+
+// missingSuffix is appended to embedded names to build names that must not exist.
+const missingSuffix = ".esctest-missing"
+
+// TestFileSystem runs the conformance checks of Check against fs and reports
+// every failure with t.Error.
+func TestFileSystem(t *testing.T, fs http.FileSystem, manifest []Entry) {
+	t.Helper()
+	for _, err := range Check(fs, manifest) {
+		t.Error(err)
+	}
+}
+
+// Check verifies that fs serves exactly the entries in manifest:
+//
+//   - every entry can be opened and its Stat fields match the manifest,
+//   - files have the expected content, also after seeking back to the start,
+//   - directories list exactly their children in the manifest, both at once
+//     and when read one entry at a time, ending with io.EOF,
+//   - opening names that are not embedded fails with an error for which
+//     os.IsNotExist is true.
+//
+// Check returns all failures found.
+func Check(fs http.FileSystem, manifest []Entry) []error {
+	var errs []error
+	errorf := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	children := make(map[string][]string)
+	for _, e := range manifest {
+		if e.Name != "/" {
+			dir := path.Dir(e.Name)
+			children[dir] = append(children[dir], path.Base(e.Name))
+		}
+	}
+
+	for _, e := range manifest {
+		f, err := fs.Open(e.Name)
+		if err != nil {
+			errorf("%s: Open() error = %v", e.Name, err)
+			continue
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			errorf("%s: Stat() error = %v", e.Name, err)
+			f.Close()
+			continue
+		}
+		if fi.IsDir() != e.IsDir {
+			errorf("%s: Stat().IsDir() = %t, want %t", e.Name, fi.IsDir(), e.IsDir)
+		}
+		if e.Name != "/" && fi.Name() != path.Base(e.Name) {
+			errorf("%s: Stat().Name() = %q, want %q", e.Name, fi.Name(), path.Base(e.Name))
+		}
+		if e.IsDir {
+			errs = append(errs, checkDir(f, e, children[e.Name])...)
+			if err := f.Close(); err != nil {
+				errorf("%s: Close() error = %v", e.Name, err)
+			}
+			if f, err = fs.Open(e.Name); err != nil {
+				errorf("%s: second Open() error = %v", e.Name, err)
+				continue
+			}
+			errs = append(errs, checkDirPaged(f, e, children[e.Name])...)
+		} else {
+			if fi.Size() != e.Size {
+				errorf("%s: Stat().Size() = %d, want %d", e.Name, fi.Size(), e.Size)
+			}
+			if e.ModTime != 0 && fi.ModTime().Unix() != e.ModTime {
+				errorf("%s: Stat().ModTime() = %d, want %d", e.Name, fi.ModTime().Unix(), e.ModTime)
+			}
+			errs = append(errs, checkFile(f, e)...)
+		}
+		if err := f.Close(); err != nil {
+			errorf("%s: Close() error = %v", e.Name, err)
+		}
+	}
+
+	missing := []string{"/esctest-does-not-exist" + missingSuffix}
+	for _, e := range manifest {
+		if !e.IsDir {
+			missing = append(missing, e.Name+missingSuffix)
+			break
+		}
+	}
+	for _, name := range missing {
+		f, err := fs.Open(name)
+		if err == nil {
+			f.Close()
+			errorf("%s: Open() of a name that is not embedded succeeded", name)
+		} else if !os.IsNotExist(err) {
+			errorf("%s: Open() error = %v, want an error for which os.IsNotExist is true", name, err)
+		}
+	}
+	return errs
+}
+
+func checkFile(f http.File, e Entry) []error {
+	var errs []error
+	for _, pass := range []string{"read", "read after seek"} {
+		b, err := ioutil.ReadAll(f)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: error = %v", e.Name, pass, err))
+			break
+		}
+		if int64(len(b)) != e.Size {
+			errs = append(errs, fmt.Errorf("%s: %s: got %d bytes, want %d", e.Name, pass, len(b), e.Size))
+		}
+		sum := sha256.Sum256(b)
+		if got := hex.EncodeToString(sum[:]); got != e.SHA256 {
+			errs = append(errs, fmt.Errorf("%s: %s: content SHA-256 = %s, want %s", e.Name, pass, got, e.SHA256))
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			errs = append(errs, fmt.Errorf("%s: Seek() error = %v", e.Name, err))
+			break
+		}
+	}
+	if _, err := f.Readdir(-1); err == nil {
+		errs = append(errs, fmt.Errorf("%s: Readdir() of a file succeeded", e.Name))
+	}
+	return errs
+}
+
+func checkDir(f http.File, e Entry, want []string) []error {
+	fis, err := f.Readdir(-1)
+	if err != nil {
+		return []error{fmt.Errorf("%s: Readdir(-1) error = %v", e.Name, err)}
+	}
+	return compareListing(e.Name, "Readdir(-1)", fis, want)
+}
+
+func checkDirPaged(f http.File, e Entry, want []string) []error {
+	var fis []os.FileInfo
+	for i := 0; i <= len(want); i++ {
+		page, err := f.Readdir(1)
+		if err == io.EOF {
+			if len(page) != 0 {
+				return []error{fmt.Errorf("%s: Readdir(1) returned %d entries with io.EOF", e.Name, len(page))}
+			}
+			return compareListing(e.Name, "Readdir(1)", fis, want)
+		}
+		if err != nil {
+			return []error{fmt.Errorf("%s: Readdir(1) error = %v", e.Name, err)}
+		}
+		if len(page) != 1 {
+			return []error{fmt.Errorf("%s: Readdir(1) returned %d entries", e.Name, len(page))}
+		}
+		fis = append(fis, page...)
+	}
+	return []error{fmt.Errorf("%s: Readdir(1) did not return io.EOF after %d entries", e.Name, len(fis))}
+}
+
+func compareListing(name, call string, fis []os.FileInfo, want []string) []error {
+	got := make([]string, 0, len(fis))
+	for _, fi := range fis {
+		got = append(got, fi.Name())
+	}
+	sort.Strings(got)
+	want = append([]string(nil), want...)
+	sort.Strings(want)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		return []error{fmt.Errorf("%s: %s names = %q, want %q", name, call, got, want)}
+	}
+	return nil
+}
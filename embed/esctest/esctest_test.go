@@ -0,0 +1,144 @@
+package esctest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// dirFS creates a tree on disk and returns it as http.Dir with its manifest.
+func dirFS(t *testing.T) (http.FileSystem, []Entry) {
+	root := t.TempDir()
+	files := map[string]string{
+		"/index.html":      "<html></html>",
+		"/css/main.css":    "body{}",
+		"/css/print.css":   "@media print{}",
+		"/js/app/a.js":     "a()",
+		"/js/app/empty.js": "",
+	}
+	manifest := []Entry{
+		{Name: "/", IsDir: true},
+		{Name: "/css", IsDir: true},
+		{Name: "/js", IsDir: true},
+		{Name: "/js/app", IsDir: true},
+	}
+	for name, content := range files {
+		fname := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fname, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256([]byte(content))
+		manifest = append(manifest, Entry{Name: name, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])})
+	}
+	return http.Dir(root), manifest
+}
+
+type genericMissFS struct{ http.FileSystem }
+
+func (fs genericMissFS) Open(name string) (http.File, error) {
+	f, err := fs.FileSystem.Open(name)
+	if err != nil {
+		return nil, errors.New("not here")
+	}
+	return f, nil
+}
+
+type hideFS struct {
+	http.FileSystem
+	hide string
+}
+
+func (fs hideFS) Open(name string) (http.File, error) {
+	f, err := fs.FileSystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return hideFile{f, fs.hide}, nil
+}
+
+type hideFile struct {
+	http.File
+	hide string
+}
+
+func (f hideFile) Readdir(count int) ([]os.FileInfo, error) {
+	fis, err := f.File.Readdir(count)
+	kept := fis[:0]
+	for _, fi := range fis {
+		if fi.Name() != f.hide {
+			kept = append(kept, fi)
+		}
+	}
+	return kept, err
+}
+
+type noPagingFS struct{ http.FileSystem }
+
+func (fs noPagingFS) Open(name string) (http.File, error) {
+	f, err := fs.FileSystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return noPagingFile{f}, nil
+}
+
+type noPagingFile struct{ http.File }
+
+// Readdir always returns the whole directory, ignoring count.
+func (f noPagingFile) Readdir(count int) ([]os.FileInfo, error) {
+	return f.File.Readdir(-1)
+}
+
+func TestCheck(t *testing.T) {
+	fs, manifest := dirFS(t)
+
+	changed := append([]Entry(nil), manifest...)
+	for i := range changed {
+		if changed[i].Name == "/css/main.css" {
+			changed[i].SHA256 = strings.Repeat("0", 64)
+		}
+	}
+	extra := append([]Entry{{Name: "/missing.txt", Size: 1}}, manifest...)
+
+	tests := []struct {
+		name     string
+		fs       http.FileSystem
+		manifest []Entry
+		wantErrs []string
+	}{
+		{"conforming", fs, manifest, nil},
+		{"generic miss error", genericMissFS{fs}, manifest, []string{"os.IsNotExist"}},
+		{"listing misses an entry", hideFS{fs, "print.css"}, manifest, []string{"/css: Readdir(-1) names", "/css: Readdir(1)"}},
+		{"no pagination", noPagingFS{fs}, manifest, []string{"Readdir(1) returned"}},
+		{"changed content", fs, changed, []string{"/css/main.css: read: content SHA-256"}},
+		{"missing entry", fs, extra, []string{"/missing.txt: Open() error"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Check(tt.fs, tt.manifest)
+			if len(tt.wantErrs) == 0 && len(errs) > 0 {
+				t.Errorf("%q. Check() = %v, want no errors", tt.name, errs)
+			}
+			for _, want := range tt.wantErrs {
+				found := false
+				for _, err := range errs {
+					if strings.Contains(err.Error(), want) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("%q. Check() = %v, want an error containing %q", tt.name, errs, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package embed
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var examplesTmpl = template.Must(template.New("").Parse(examplesTemplate))
+
+// examplesFileName returns the name of the example test file written next
+// to outputFile.
+func examplesFileName(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_example_test.go"
+}
+
+// exampleFile picks the file the examples use: the first by name that is not
+// an index.html, which http.FileServer redirects, else the first.
+func (p *Plan) exampleFile() *_escFile {
+	for _, f := range p.files {
+		if f.BaseName != "index.html" {
+			return f
+		}
+	}
+	return p.files[0]
+}
+
+// examples returns a test file with runnable examples of the generated
+// functions for a file of p.
+func (p *Plan) examples(invocation, functionPrefix string) ([]byte, error) {
+	if p.conf.OutputFile == "" {
+		return nil, errors.New("examples require an output file")
+	}
+	if p.conf.MetadataOnly {
+		return nil, errors.New("examples require embedded file contents")
+	}
+	if len(p.files) == 0 {
+		return nil, errors.New("examples require an embedded file")
+	}
+	f := p.exampleFile()
+	var buf bytes.Buffer
+	if err := examplesTmpl.Execute(&buf, map[string]interface{}{
+		"Invocation":     invocation,
+		"PackageName":    p.conf.Package,
+		"BuildTags":      p.conf.BuildTags,
+		"FunctionPrefix": functionPrefix,
+		"Name":           f.Name,
+		"Dir":            path.Dir(f.Name),
+		"BaseName":       f.BaseName,
+		"Size":           f.Size,
+	}); err != nil {
+		return nil, errors.Wrap(err, "examples template execution")
+	}
+	data, err := formatFile(p.conf, renameIdents(buf.Bytes(), p.conf.IdentPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "format examples")
+	}
+	return data, nil
+}
+
+const examplesTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+// Serve the embedded files with http.FileServer.
+func Example{{.FunctionPrefix}}FS() {
+	handler := http.FileServer({{.FunctionPrefix}}FS(false))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", {{printf "%q" .Name}}, nil))
+	fmt.Println(rec.Code, rec.Body.Len())
+	// Output: 200 {{.Size}}
+}
+
+// Read an embedded file, panicking if it is missing.
+func Example{{.FunctionPrefix}}FSMustByte() {
+	b := {{.FunctionPrefix}}FSMustByte(false, {{printf "%q" .Name}})
+	fmt.Println(len(b))
+	// Output: {{.Size}}
+}
+
+// Open files relative to an embedded directory.
+func Example{{.FunctionPrefix}}Dir() {
+	dir := {{.FunctionPrefix}}Dir(false, {{printf "%q" .Dir}})
+	f, err := dir.Open({{printf "%q" (print "/" .BaseName)}})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(fi.Name(), fi.Size())
+	// Output: {{.BaseName}} {{.Size}}
+}
+`
@@ -0,0 +1,42 @@
+package embed
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunExamples(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"index.html":    "<html></html>",
+		"css/main.css":  "body{}",
+		"css/print.css": "@media print{}",
+	})
+	for _, private := range []bool{false, true} {
+		output := filepath.Join(t.TempDir(), "static.go")
+		conf := &Config{
+			OutputFile:       output,
+			Package:          "main",
+			Prefix:           root,
+			Private:          private,
+			Files:            []string{root},
+			GenerateExamples: true,
+		}
+		if err := Run(conf, ioutil.Discard); err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(output), "static_example_test.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(b), `"/css/main.css"`) {
+			t.Errorf("examples do not use /css/main.css:\n%s", b)
+		}
+		out := runGenerated(t, conf, map[string]string{"static_example_test.go": string(b)}, "test", "-v", "-run", "Example", ".")
+		if strings.Count(out, "--- PASS: Example") != 3 {
+			t.Errorf("go test output = %s, want 3 passing examples", out)
+		}
+	}
+}
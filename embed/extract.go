@@ -0,0 +1,370 @@
+package embed
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/modfile"
+)
+
+var extractTmpl = template.Must(template.New("").Parse(extractTemplate))
+
+// Extract writes the files embedded in outputFile, generated by esc, and
+// their directories to dir under their canonical names, with their
+// modification times and permissions. As only the generated code reads
+// every encoding, it builds outputFile, its shards, its sidecar data file
+// and its go:embed data directory as a program with the go command and runs
+// it. Encrypted outputs read their key from the environment as usual.
+// Outputs reading their files from an embed.FS variable declared elsewhere
+// in their package, see Config.WrapEmbedVar, cannot be extracted.
+func Extract(outputFile, dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	_, err = runOutputProgram(outputFile, extractTmpl, dir)
+	return err
+}
+
+// runOutputProgram runs the program tmpl, executed with the function prefix
+// of outputFile, with outputFile, its shards, its sidecar data file and its
+// go:embed data directory, and args. It returns the standard output of the
+// program.
+func runOutputProgram(outputFile string, tmpl *template.Template, args ...string) ([]byte, error) {
+	tmp, err := ioutil.TempDir("", "esc-output")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	shards, err := shardFiles(outputFile)
+	if err != nil {
+		return nil, err
+	}
+	var out *generatedSource
+	for _, name := range append([]string{outputFile}, shards...) {
+		g, err := outputSource(name)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			out = g
+		}
+		if err := ioutil.WriteFile(filepath.Join(tmp, filepath.Base(name)), g.src, 0644); err != nil {
+			return nil, err
+		}
+	}
+	prefix, err := outputFunctionPrefix(outputFile, out.funcs)
+	if err != nil {
+		return nil, err
+	}
+	if out.wrapsEmbedVar(prefix) {
+		return nil, errors.Errorf("%s reads its files from an embed.FS variable declared elsewhere in its package, run the program of that package instead", outputFile)
+	}
+	if b, err := ioutil.ReadFile(sidecarFileName(outputFile)); err == nil {
+		if err := ioutil.WriteFile(filepath.Join(tmp, filepath.Base(sidecarFileName(outputFile))), b, 0644); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, pattern := range out.embeds {
+		if err := copyEmbedded(filepath.Dir(outputFile), tmp, pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	var prog bytes.Buffer
+	if err := tmpl.Execute(&prog, prefix); err != nil {
+		return nil, errors.Wrap(err, "program template execution")
+	}
+	goMod, goSum, err := outputModule(outputFile, out.imports)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{
+		"go.mod":      goMod,
+		"go.sum":      goSum,
+		"esc_main.go": prog.Bytes(),
+	}
+	for name, b := range files {
+		if err := ioutil.WriteFile(filepath.Join(tmp, name), b, 0644); err != nil {
+			return nil, err
+		}
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", append([]string{"run", "."}, args...)...)
+	cmd.Dir = tmp
+	// The program reads the embedded files, not the local ones the
+	// environment variable of Config.LocalEnv switches to.
+	cmd.Env = os.Environ()
+	if out.localEnv != "" {
+		cmd.Env = append(cmd.Env, out.localEnv+"=")
+	}
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("run %s: %v\n%s", outputFile, err, stderr.Bytes())
+	}
+	return stdout.Bytes(), nil
+}
+
+// generatedSource is a file generated by esc as rewritten by outputSource.
+type generatedSource struct {
+	src []byte
+	// funcs are the names of the functions of the file.
+	funcs []string
+	// embeds are the patterns of the go:embed directives of the file.
+	embeds []string
+	// embedFS reports whether the file declares an embed.FS variable.
+	embedFS bool
+	// localEnv is the environment variable of Config.LocalEnv, if any.
+	localEnv string
+	// imports are the packages outside the standard library the file
+	// imports, e.g. to decode Config.Compression.
+	imports []string
+}
+
+// wrapsEmbedVar reports whether g reads its files from an embed.FS variable
+// it does not declare, as with Config.WrapEmbedVar.
+func (g *generatedSource) wrapsEmbedVar(prefix string) bool {
+	if g.embedFS {
+		return false
+	}
+	for _, fn := range g.funcs {
+		if fn == prefix+"FSSelfCheck" {
+			return true
+		}
+	}
+	return false
+}
+
+// outputSource returns the source of the generated file name as part of
+// package main without build constraints, and what runOutputProgram needs
+// to know about it.
+func outputSource(name string) (*generatedSource, error) {
+	src, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	g := new(generatedSource)
+	for _, imp := range f.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err == nil && strings.Contains(strings.Split(p, "/")[0], ".") {
+			g.imports = append(g.imports, p)
+		}
+	}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil {
+				continue
+			}
+			g.funcs = append(g.funcs, d.Name.Name)
+			if strings.HasPrefix(d.Name.Name, "_") && strings.HasSuffix(d.Name.Name, "LocalEnv") {
+				g.localEnv = firstString(d)
+			}
+		case *ast.GenDecl:
+			if d.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range d.Specs {
+				if isEmbedFS(spec.(*ast.ValueSpec).Type) {
+					g.embedFS = true
+				}
+			}
+		}
+	}
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "//go:embed ") {
+				g.embeds = append(g.embeds, strings.Fields(strings.TrimPrefix(c.Text, "//go:embed "))...)
+			}
+		}
+	}
+	var b bytes.Buffer
+	for _, line := range strings.SplitAfter(string(src[:fset.Position(f.Package).Offset]), "\n") {
+		if !strings.HasPrefix(line, "//go:build ") && !strings.HasPrefix(line, "// +build ") {
+			b.WriteString(line)
+		}
+	}
+	b.WriteString("package main")
+	b.Write(src[fset.Position(f.Name.End()).Offset:])
+	g.src = b.Bytes()
+	return g, nil
+}
+
+// outputModule returns the go.mod and go.sum of the program run for
+// outputFile, which require the modules of imports at the versions the
+// module of outputFile requires.
+func outputModule(outputFile string, imports []string) (goMod, goSum []byte, err error) {
+	goMod = []byte("module escoutput\n\ngo 1.18\n")
+	if len(imports) == 0 {
+		return goMod, nil, nil
+	}
+	dir, _, err := findModule(filepath.Dir(outputFile))
+	if err != nil || dir == "" {
+		return goMod, nil, err
+	}
+	name := filepath.Join(dir, "go.mod")
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	mod, err := modfile.Parse(name, b, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	sums, err := ioutil.ReadFile(filepath.Join(dir, "go.sum"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	for _, r := range mod.Require {
+		if !importsModule(imports, r.Mod.Path) {
+			continue
+		}
+		goMod = append(goMod, "\nrequire "+r.Mod.Path+" "+r.Mod.Version+"\n"...)
+		for _, line := range strings.SplitAfter(string(sums), "\n") {
+			if strings.HasPrefix(line, r.Mod.Path+" ") {
+				goSum = append(goSum, line...)
+			}
+		}
+	}
+	return goMod, goSum, nil
+}
+
+// importsModule reports whether any of imports is in the module modPath.
+func importsModule(imports []string, modPath string) bool {
+	for _, p := range imports {
+		if p == modPath || strings.HasPrefix(p, modPath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmbedFS reports whether the type expression typ is embed.FS.
+func isEmbedFS(typ ast.Expr) bool {
+	sel, ok := typ.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == "embed" && sel.Sel.Name == "FS"
+}
+
+// firstString returns the value of the first string literal in n, or "".
+func firstString(n ast.Node) string {
+	var s string
+	ast.Inspect(n, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && s == "" {
+			s, _ = strconv.Unquote(lit.Value)
+		}
+		return s == ""
+	})
+	return s
+}
+
+// copyEmbedded copies the files matching the go:embed pattern in dir to the
+// same place in tmp.
+func copyEmbedded(dir, tmp, pattern string) error {
+	matches, err := filepath.Glob(filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(pattern, "all:"))))
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		err := filepath.Walk(match, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				return os.MkdirAll(filepath.Join(tmp, rel), 0755)
+			}
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(filepath.Join(tmp, rel), b, 0644)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outputFunctionPrefix returns the function prefix of the generated file name
+// from its functions, funcs.
+func outputFunctionPrefix(name string, funcs []string) (string, error) {
+	for _, fn := range funcs {
+		if strings.HasSuffix(fn, "FSNames") {
+			return strings.TrimSuffix(fn, "FSNames"), nil
+		}
+	}
+	return "", errors.Errorf("%s: no FSNames function, not generated by this version of esc", name)
+}
+
+const extractTemplate = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := os.Args[1]
+	for _, name := range {{.}}FSDirNames() {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.FromSlash(name)), 0755); err != nil {
+			fail(err)
+		}
+	}
+	for _, name := range {{.}}FSNames() {
+		fi, err := {{.}}FSStat(name)
+		if err != nil {
+			fail(err)
+		}
+		b, err := {{.}}FSByte(false, name)
+		if err != nil {
+			fail(err)
+		}
+		mode := fi.Mode().Perm()
+		if mode == 0 {
+			mode = 0644
+		}
+		local := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+			fail(err)
+		}
+		if err := ioutil.WriteFile(local, b, mode); err != nil {
+			fail(err)
+		}
+		if err := os.Chtimes(local, fi.ModTime(), fi.ModTime()); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+`
@@ -0,0 +1,119 @@
+package embed
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExtract(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"index.html":      "<html></html>",
+		"css/app.css":     strings.Repeat("body{}", 100),
+		"fonts/font.woff": "\x00\x01binary",
+	}
+	writeTree(t, root, files)
+	for name, conf := range map[string]*Config{
+		"default": {},
+		"private": {Private: true, LookupMode: LookupCompact},
+		"sharded": {ShardSize: 100},
+		"sidecar": {Encoding: EncodingSidecar},
+		"goembed": {UseGoEmbed: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out := t.TempDir()
+			conf.OutputFile = filepath.Join(out, "static.go")
+			conf.Package = "assets"
+			conf.Files = []string{root}
+			conf.Prefix = root
+			conf.ModTime = "1700000000"
+			conf.SkipModuleCheck = true
+			var src strings.Builder
+			if err := Run(conf, &src); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(conf.OutputFile, []byte(src.String()), 0644); err != nil {
+				t.Fatal(err)
+			}
+			dir := filepath.Join(t.TempDir(), "extracted")
+			if err := Extract(conf.OutputFile, dir); err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range files {
+				local := filepath.Join(dir, filepath.FromSlash(name))
+				b, err := ioutil.ReadFile(local)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(b) != want {
+					t.Errorf("%s = %q, want %q", name, b, want)
+				}
+				fi, err := os.Stat(local)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !fi.ModTime().Equal(time.Unix(1700000000, 0)) {
+					t.Errorf("%s modified at %v", name, fi.ModTime())
+				}
+			}
+		})
+	}
+
+	if err := Extract(filepath.Join(root, "index.html"), t.TempDir()); err == nil {
+		t.Error("Extract() of a file not generated by esc succeeded")
+	}
+
+	out := filepath.Join(root, "static.go")
+	conf := &Config{
+		OutputFile:      out,
+		Package:         "assets",
+		Files:           []string{root},
+		Prefix:          root,
+		WrapEmbedVar:    "assetsFS",
+		SkipModuleCheck: true,
+	}
+	var src strings.Builder
+	if err := Run(conf, &src); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(out, []byte(src.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Extract(out, t.TempDir()); err == nil || !strings.Contains(err.Error(), "embed.FS variable declared elsewhere") {
+		t.Errorf("Extract() of an output wrapping an embed.FS variable = %v", err)
+	}
+}
+
+func TestExtractIgnoresLocalEnv(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"a.txt": "embedded"})
+	out := filepath.Join(t.TempDir(), "static.go")
+	conf := &Config{
+		OutputFile:      out,
+		Package:         "assets",
+		Files:           []string{root},
+		Prefix:          root,
+		LocalEnv:        "ESC_TEST_LOCAL",
+		SkipModuleCheck: true,
+	}
+	var src strings.Builder
+	if err := Run(conf, &src); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(out, []byte(src.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeTree(t, root, map[string]string{"a.txt": "local"})
+	t.Setenv("ESC_TEST_LOCAL", "1")
+	dir := t.TempDir()
+	if err := Extract(out, dir); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "a.txt")); err != nil || string(b) != "embedded" {
+		t.Errorf("a.txt = %q, %v", b, err)
+	}
+}
@@ -0,0 +1,134 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/tools/imports"
+
+	"github.com/sbstnsp/esc/embed/internal/printer"
+)
+
+// Format profiles for Config.FormatCompat.
+const (
+	// FormatDefault formats the output with goimports.
+	FormatDefault = ""
+	// FormatV1 removes unused imports of the import block and formats the
+	// output with a copy of go/printer, so it does not change with
+	// goimports or the Go version, and deflates gzip data with
+	// github.com/klauspost/compress at the version esc requires.
+	FormatV1 = "v1"
+)
+
+// importsMu guards imports.LocalPrefix, which is global.
+var importsMu sync.Mutex
+
+// processImports is imports.Process, replaced in tests.
+var processImports = imports.Process
+
+// formatOutput formats src and fixes its imports as if it were the file
+// filename, which should be the absolute path the output is written to, so
+// imports resolve against the right module.
+func formatOutput(filename string, src []byte, localPrefix string) ([]byte, error) {
+	importsMu.Lock()
+	defer importsMu.Unlock()
+	saved := imports.LocalPrefix
+	imports.LocalPrefix = localPrefix
+	defer func() { imports.LocalPrefix = saved }()
+
+	data, err := processImports(filename, src, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "imports.Process return error")
+	}
+	return data, nil
+}
+
+// formatCompat formats src according to profile.
+func formatCompat(profile, filename string, src []byte, localPrefix string) ([]byte, error) {
+	switch profile {
+	case FormatDefault:
+		return formatOutput(filename, src, localPrefix)
+	case FormatV1:
+		if localPrefix != "" {
+			return nil, fmt.Errorf("format profile %s does not group imports by local prefix", profile)
+		}
+		return formatV1(src)
+	}
+	return nil, fmt.Errorf("unknown format profile %q", profile)
+}
+
+// formatFile formats src, a file written next to the output, with the copy
+// of go/printer for FormatV1 and else with go/format.
+func formatFile(conf *Config, src []byte) ([]byte, error) {
+	if conf.FormatCompat == FormatV1 {
+		return printer.Source(src)
+	}
+	return format.Source(src)
+}
+
+// formatV1 removes the lines of unused imports from src, which must have one
+// import per line, and formats the result with the copy of go/printer.
+func formatV1(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse output")
+	}
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			// Package names are the identifiers the parser does not resolve.
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	unused := make(map[int]bool)
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name != "_" && !used[name] {
+			unused[fset.Position(spec.Pos()).Line] = true
+		}
+	}
+	var buf bytes.Buffer
+	for i, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if !unused[i+1] {
+			buf.Write(line)
+		}
+	}
+	data, err := printer.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "format output")
+	}
+	return data, nil
+}
+
+// outputPath returns the absolute path of the generated file. Without an
+// OutputFile, it is static.go in the working directory.
+func outputPath(conf *Config) (string, error) {
+	name := conf.OutputFile
+	if name == "" {
+		name = "static.go"
+	}
+	if filepath.IsAbs(name) {
+		return name, nil
+	}
+	return filepath.Abs(filepath.Join(conf.WorkingDir, name))
+}
@@ -0,0 +1,141 @@
+package embed
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/imports"
+)
+
+func TestRunFormatsAtOutputPath(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"go.mod":                "module example.com/outer\n\ngo 1.18\n",
+		"inner/go.mod":          "module example.com/inner\n\ngo 1.18\n",
+		"inner/assets/a.txt":    "a",
+		"inner/assets/other.go": "package assets\n",
+	})
+	inner := filepath.Join(root, "inner")
+
+	defer func(saved func(string, []byte, *imports.Options) ([]byte, error)) { processImports = saved }(processImports)
+	var gotName, gotPrefix string
+	processImports = func(filename string, src []byte, opt *imports.Options) ([]byte, error) {
+		gotName, gotPrefix = filename, imports.LocalPrefix
+		return imports.Process(filename, src, opt)
+	}
+
+	tests := []struct {
+		name string
+		conf *Config
+		want string
+	}{
+		{"relative output in working dir", &Config{WorkingDir: inner, OutputFile: filepath.Join("assets", "static.go")}, filepath.Join(inner, "assets", "static.go")},
+		{"stdout in working dir", &Config{WorkingDir: filepath.Join(inner, "assets")}, filepath.Join(inner, "assets", "static.go")},
+		{"absolute output", &Config{WorkingDir: root, OutputFile: filepath.Join(inner, "assets", "static.go")}, filepath.Join(inner, "assets", "static.go")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conf.Package = "assets"
+			tt.conf.FormatLocalPrefix = "example.com/inner"
+			tt.conf.Files = []string{filepath.Join(inner, "assets", "a.txt")}
+			if err := Run(tt.conf, ioutil.Discard); err != nil {
+				t.Fatal(err)
+			}
+			if gotName != tt.want {
+				t.Errorf("%q. formatted as %s, want %s", tt.name, gotName, tt.want)
+			}
+			if gotPrefix != "example.com/inner" {
+				t.Errorf("%q. formatted with local prefix %q", tt.name, gotPrefix)
+			}
+			if imports.LocalPrefix != "" {
+				t.Errorf("%q. imports.LocalPrefix = %q after Run, want it restored", tt.name, imports.LocalPrefix)
+			}
+		})
+	}
+}
+
+var update = flag.Bool("update", false, "update the golden outputs in testdata/compat and testdata/golden")
+
+// TestFormatCompatCorpus checks that FormatV1 output does not change. It
+// must not change with the Go version esc is built with; changes to esc
+// itself update the corpus with -update.
+func TestFormatCompatCorpus(t *testing.T) {
+	defer func(saved func(string, []byte, *imports.Options) ([]byte, error)) { processImports = saved }(processImports)
+	processImports = func(string, []byte, *imports.Options) ([]byte, error) {
+		return nil, errors.New("goimports must not be used by FormatV1")
+	}
+
+	input := filepath.Join("testdata", "compat", "input")
+	tests := []struct {
+		name string
+		edit func(*Config)
+	}{
+		{"default", func(*Config) {}},
+		{"private", func(c *Config) { c.Private = true }},
+		{"fingerprint", func(c *Config) { c.Fingerprint, c.MutableMetadata = true, true }},
+		{"metadata", func(c *Config) { c.MetadataOnly = true }},
+		{"compressed", func(c *Config) {
+			c.NoCompression = false
+			c.Invocation = "-pkg assets -format-compat v1 -file-mode 0644 " + filepath.ToSlash(input)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &Config{
+				Package:       "assets",
+				Prefix:        input,
+				ModTime:       "0",
+				FileMode:      0644,
+				NoCompression: true,
+				FormatCompat:  FormatV1,
+				Invocation:    "-pkg assets -format-compat v1 -no-compress -file-mode 0644 " + filepath.ToSlash(input),
+				Files:         []string{input},
+			}
+			tt.edit(conf)
+			var buf bytes.Buffer
+			if err := Run(conf, &buf); err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", "compat", tt.name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("output differs from %s, run go test -update if esc changed", golden)
+			}
+		})
+	}
+}
+
+func TestFormatV1(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"a.txt": "a"})
+	conf := &Config{Package: "main", Prefix: root, FormatCompat: FormatV1, Files: []string{root}}
+	var buf bytes.Buffer
+	if err := Run(conf, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte(`"embed"`)) || bytes.Contains(buf.Bytes(), []byte("\n\n\n")) {
+		t.Errorf("FormatV1 output has unused imports or blank lines:\n%s", buf.Bytes())
+	}
+	runGenerated(t, conf, nil, "vet", ".")
+
+	for _, conf := range []*Config{
+		{Package: "main", FormatCompat: "v0", Files: []string{root}},
+		{Package: "main", FormatCompat: FormatV1, FormatLocalPrefix: "example.com", Files: []string{root}},
+	} {
+		if err := Run(conf, ioutil.Discard); err == nil {
+			t.Errorf("Run() with FormatCompat %q and local prefix %q succeeded, want error", conf.FormatCompat, conf.FormatLocalPrefix)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitignoreRule is a pattern of a .gitignore file matching paths relative
+// to the directory holding the file.
+type gitignoreRule struct {
+	dir     string
+	glob    glob
+	negate  bool
+	dirOnly bool
+}
+
+// escIgnoreFile is the file at the root of an embedded directory holding
+// patterns, in the syntax of .gitignore, for paths in it not to embed.
+const escIgnoreFile = ".escignore"
+
+// gitignore holds the rules of the .gitignore and .escignore files of
+// walked directories in the order they were read, so the rules of a
+// directory follow those of its parents.
+type gitignore struct {
+	rules []gitignoreRule
+	files []patternFile
+	read  map[string]bool
+}
+
+// load adds the rules of the file name in dir, if there is one, recording
+// it as a pattern file of kind.
+func (g *gitignore) load(dir, name, kind string) error {
+	file := filepath.Join(dir, name)
+	if g.read[file] {
+		return nil
+	}
+	if g.read == nil {
+		g.read = make(map[string]bool)
+	}
+	g.read[file] = true
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	rules, err := parseGitignore(file, b)
+	if err != nil {
+		return err
+	}
+	for i := range rules {
+		rules[i].dir = dir
+	}
+	g.rules = append(g.rules, rules...)
+	sum := sha256.Sum256(b)
+	g.files = append(g.files, patternFile{Kind: kind, Path: file, Hash: hex.EncodeToString(sum[:])[:16]})
+	return nil
+}
+
+// ignored reports whether name, a directory if isDir, is ignored. The last
+// matching rule wins, so a negated pattern includes a path again.
+func (g *gitignore) ignored(name string, isDir bool) bool {
+	ignored := false
+	for _, r := range g.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		if !strings.HasPrefix(name, r.dir+string(filepath.Separator)) {
+			continue
+		}
+		if r.glob.MatchString(name[len(r.dir)+1:]) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+// parseGitignore parses the patterns of the gitignore file named file. As
+// in git, a leading ! negates a pattern, a trailing / restricts it to
+// directories, and a pattern without an inner / matches names at any depth.
+// Errors are reported with the file name and line number.
+func parseGitignore(file string, b []byte) ([]gitignoreRule, error) {
+	var rules []gitignoreRule
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; s.Scan(); line++ {
+		pattern := strings.TrimSuffix(s.Text(), "\r")
+		for strings.HasSuffix(pattern, " ") && !strings.HasSuffix(pattern, "\\ ") {
+			pattern = pattern[:len(pattern)-1]
+		}
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		var r gitignoreRule
+		if strings.HasPrefix(pattern, "!") {
+			r.negate = true
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			r.dirOnly = true
+			pattern = strings.TrimRight(pattern, "/")
+		}
+		if pattern == "" {
+			continue
+		}
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else {
+			pattern = "**/" + pattern
+		}
+		g, err := compileGlob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", file, line, err)
+		}
+		r.glob = g
+		rules = append(rules, r)
+	}
+	return rules, s.Err()
+}
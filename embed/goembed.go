@@ -0,0 +1,164 @@
+package embed
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/module"
+)
+
+// goEmbedVar is the embed.FS variable generated with Config.UseGoEmbed.
+const goEmbedVar = "_escEmbedFS"
+
+// goEmbedDir returns the name of the data directory written next to the
+// output file with Config.UseGoEmbed, e.g. "static_data" for "static.go".
+func goEmbedDir(conf *Config) string {
+	return strings.TrimSuffix(filepath.Base(conf.OutputFile), ".go") + "_data"
+}
+
+// checkGoEmbed validates the Config of a go:embed output.
+func checkGoEmbed(conf *Config) error {
+	switch {
+	case conf.OutputFile == "":
+		return errors.New("go:embed output requires an output file to write the data directory next to")
+	case conf.MetadataOnly:
+		return errors.New("go:embed output cannot be combined with metadata only")
+	case conf.WrapEmbedVar != "":
+		return errors.New("go:embed output cannot be combined with an embed.FS variable to wrap")
+	case len(conf.DualStorage) > 0:
+		return errors.New("go:embed output cannot be combined with dual storage")
+	}
+	return nil
+}
+
+// setGoEmbedPaths records where the files are written in the data
+// directory, failing for names go:embed cannot embed.
+func (p *Plan) setGoEmbedPaths() error {
+	dir := goEmbedDir(p.conf)
+	for _, f := range p.files {
+		f.EmbedPath = dir + f.Name
+		if err := module.CheckFilePath(f.EmbedPath); err != nil {
+			return fmt.Errorf("%s: cannot be embedded with go:embed: %v", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// goEmbedData returns the data directory and the files of p to write to
+// it, by name.
+func (p *Plan) goEmbedData() (dir string, files map[string][]byte, err error) {
+	out, err := outputDir(p.conf)
+	if err != nil {
+		return "", nil, err
+	}
+	files = make(map[string][]byte, len(p.files))
+	for _, f := range p.files {
+		files[filepath.Join(out, filepath.FromSlash(f.EmbedPath))] = f.Data
+	}
+	return filepath.Join(out, goEmbedDir(p.conf)), files, nil
+}
+
+// writeGoEmbedData writes the files of p to the data directory through c,
+// removing those left over from earlier runs.
+func (p *Plan) writeGoEmbedData(c *cleanups) error {
+	dir, files, err := p.goEmbedData()
+	if err != nil {
+		return err
+	}
+	keep := make(map[string]bool, len(files))
+	for name := range files {
+		keep[name] = true
+	}
+	if err := c.removeStale(dir, keep); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(files) {
+		if err := c.mkdirAll(filepath.Dir(name)); err != nil {
+			return err
+		}
+		if err := c.writeFile(name, files[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStale removes the files under dir that are not in keep, and the
+// directories left empty, registering restoring them.
+func (c *cleanups) removeStale(dir string, keep map[string]bool) error {
+	var dirs []string
+	err := filepath.Walk(dir, func(name string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			dirs = append(dirs, name)
+			return nil
+		}
+		if keep[name] {
+			return nil
+		}
+		return c.remove(name)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	// Deeper directories come later in walk order.
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+	for _, d := range dirs {
+		if d == dir {
+			continue
+		}
+		if err := os.Remove(d); err == nil {
+			d := d
+			c.add(func() { os.Mkdir(d, 0755) })
+		}
+	}
+	return nil
+}
+
+// mkdirAll creates dir and its missing parents, registering removing them.
+func (c *cleanups) mkdirAll(dir string) error {
+	var missing []string
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(d); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		missing = append(missing, d)
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		d := missing[i]
+		if err := os.Mkdir(d, 0755); err != nil {
+			return err
+		}
+		c.add(func() { os.Remove(d) })
+	}
+	return nil
+}
+
+// isGoEmbedData reports whether fname, a file or directory to embed, is in
+// the data directory of a go:embed output, which esc skips so that the
+// directory of the output file can be embedded.
+func isGoEmbedData(conf *Config, fname string) bool {
+	out, err := outputDir(conf)
+	if err != nil {
+		return false
+	}
+	abs, err := filepath.Abs(fname)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Join(out, goEmbedDir(conf)), abs)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,118 @@
+package embed
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// goldenInput is the corpus the golden outputs are generated from.
+var goldenInput = filepath.Join("testdata", "golden", "site")
+
+// goldenCases are the Config variations with golden outputs in
+// testdata/golden. Each starts from a Config embedding goldenInput without
+// compression and formatted with FormatV1, so the outputs only change with
+// esc itself. Regenerate them with go test -update.
+var goldenCases = []struct {
+	name string
+	edit func(*Config)
+	// sources are more files of the generated package, by name.
+	sources map[string]string
+}{
+	{name: "default", edit: func(*Config) {}},
+	{name: "compressed", edit: func(c *Config) { c.NoCompression = false }},
+	{name: "private", edit: func(c *Config) { c.Private = true }},
+	{name: "no-prefix", edit: func(c *Config) { c.Prefix = "" }},
+	{name: "include", edit: func(c *Config) { c.Include = `\.(css|js)$` }},
+	{name: "ignore", edit: func(c *Config) { c.Ignore = `\.svg$` }},
+	{name: "inline", edit: func(c *Config) { c.InlineFiles = map[string][]byte{"/build/stamp.txt": []byte("v1")} }},
+	{name: "fingerprint", edit: func(c *Config) { c.Fingerprint = true }},
+	{name: "metadata-only", edit: func(c *Config) { c.MetadataOnly = true }},
+	{name: "mutable-metadata", edit: func(c *Config) { c.MutableMetadata = true }},
+	{name: "metadata-only-mutable", edit: func(c *Config) { c.MetadataOnly, c.MutableMetadata = true, true }},
+	{
+		name: "wrap-embed-var",
+		edit: func(c *Config) {
+			c.OutputFile = filepath.Join(goldenInput, "static.go")
+			c.WrapEmbedVar = "files"
+		},
+		sources: map[string]string{"files.go": "package assets\n\nimport \"embed\"\n\nvar files embed.FS\n"},
+	},
+	{name: "binary-search", edit: func(c *Config) { c.LookupMode = LookupBinarySearch }},
+	{name: "compact", edit: func(c *Config) { c.LookupMode = LookupCompact }},
+	{name: "interface", edit: func(c *Config) { c.Interface = true }},
+	{name: "string-encoding", edit: func(c *Config) { c.Encoding = EncodingString }},
+	{name: "packed-encoding", edit: func(c *Config) { c.Encoding = EncodingPacked }},
+	{name: "dual-storage", edit: func(c *Config) { c.DualStorage = []string{"/js/*"} }},
+	{name: "private-interface-compact", edit: func(c *Config) { c.Private, c.Interface, c.LookupMode = true, true, LookupCompact }},
+}
+
+func TestGolden(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not found for type checking")
+	}
+	for _, tt := range goldenCases {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &Config{
+				Package:       "assets",
+				Prefix:        goldenInput,
+				ModTime:       "0",
+				FileMode:      0644,
+				NoCompression: true,
+				FormatCompat:  FormatV1,
+				Invocation:    "golden " + tt.name,
+				Files:         []string{goldenInput},
+				Warn:          func(string) {},
+			}
+			tt.edit(conf)
+			var buf bytes.Buffer
+			if err := Run(conf, &buf); err != nil {
+				t.Fatal(err)
+			}
+			typeCheck(t, buf.Bytes(), tt.sources)
+			golden := filepath.Join("testdata", "golden", tt.name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("output differs from %s, run go test -update if esc changed", golden)
+			}
+		})
+	}
+}
+
+// typeCheck type checks the generated src together with the other sources
+// of its package.
+func typeCheck(t *testing.T, src []byte, sources map[string]string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "static.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []*ast.File{f}
+	for name, source := range sources {
+		f, err := parser.ParseFile(fset, name, source, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	if _, err := conf.Check(f.Name.Name, fset, files, nil); err != nil {
+		t.Errorf("generated code does not type check: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package embed
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Group is a named set of files embedded under "/" and its name, with
+// functions of the same name, e.g. TemplatesFS for the group "templates".
+type Group struct {
+	// Name is the name of the group, an identifier starting with a lower
+	// case letter, e.g. "templates".
+	Name string
+	// Files and Prefix are like those of Config, for the files of the
+	// group.
+	Files  []string
+	Prefix string
+}
+
+// groupParams is a Group as seen by the template.
+type groupParams struct {
+	Name string
+	// Func is Name with its first letter upper cased, e.g. "Templates".
+	Func string
+}
+
+// checkGroups returns an error if groups are not valid, unique groups.
+func checkGroups(groups []Group) error {
+	seen := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		r, _ := utf8.DecodeRuneInString(g.Name)
+		if !token.IsIdentifier(g.Name) || !unicode.IsLower(r) {
+			return errors.Errorf("group name %q is not an identifier starting with a lower case letter", g.Name)
+		}
+		if seen[g.Name] {
+			return errors.Errorf("duplicate group %q", g.Name)
+		}
+		seen[g.Name] = true
+		if len(g.Files) == 0 {
+			return errors.Errorf("group %q has no files", g.Name)
+		}
+	}
+	return nil
+}
+
+// groupParamsOf returns the template parameters of groups.
+func groupParamsOf(groups []Group) []groupParams {
+	var params []groupParams
+	for _, g := range groups {
+		r, n := utf8.DecodeRuneInString(g.Name)
+		params = append(params, groupParams{Name: g.Name, Func: strings.ToUpper(string(r)) + g.Name[n:]})
+	}
+	return params
+}
@@ -0,0 +1,61 @@
+package embed
+
+import (
+	"bytes"
+	"go/scanner"
+	"go/token"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// defaultIdentPrefix starts the unexported identifiers of the generated code
+// unless Config.IdentPrefix replaces it.
+const defaultIdentPrefix = "_esc"
+
+// checkPrefixes validates Config.IdentPrefix and Config.FunctionPrefix.
+func checkPrefixes(conf *Config) error {
+	if p := conf.IdentPrefix; p != "" && (!token.IsIdentifier(p) || token.IsExported(p)) {
+		return errors.Errorf("identifier prefix %q is not an unexported identifier", p)
+	}
+	if p := conf.FunctionPrefix; p != "" && !token.IsIdentifier(p+"FS") {
+		return errors.Errorf("function prefix %q does not start identifiers", p)
+	}
+	return nil
+}
+
+// renameIdents returns src with the prefix defaultIdentPrefix of every
+// identifier, also after Test as in test names, replaced by prefix. Literals
+// and comments are left alone.
+func renameIdents(src []byte, prefix string) []byte {
+	if prefix == "" || prefix == defaultIdentPrefix {
+		return src
+	}
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	s.Init(fset.AddFile("", fset.Base(), len(src)), src, nil, 0)
+	var out bytes.Buffer
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.IDENT {
+			continue
+		}
+		head := ""
+		if strings.HasPrefix(lit, "Test"+defaultIdentPrefix) {
+			head = "Test"
+		}
+		if !strings.HasPrefix(lit[len(head):], defaultIdentPrefix) {
+			continue
+		}
+		offset := fset.Position(pos).Offset
+		out.Write(src[last:offset])
+		out.WriteString(head + prefix + lit[len(head)+len(defaultIdentPrefix):])
+		last = offset + len(lit)
+	}
+	out.Write(src[last:])
+	return out.Bytes()
+}
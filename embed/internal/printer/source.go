@@ -0,0 +1,31 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package printer
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+)
+
+// config is the printer configuration of gofmt.
+var config = Config{Mode: UseSpaces | TabIndent | normalizeNumbers, Tabwidth: 8}
+
+// Source formats the complete Go source file src like go/format.Source,
+// with the copy of go/printer in this package, which does not change with
+// the Go version.
+func Source(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	SortImports(fset, file)
+	var buf bytes.Buffer
+	if err := config.Fprint(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,109 @@
+package embed
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// configKeys are the keys of a Config field that name embedded files or
+// directories, as canonical names or path.Match patterns.
+type configKeys struct {
+	Field string
+	Keys  []string
+}
+
+// nameKeys returns the fields of conf keyed by embedded names. Every such
+// field is listed here, so a key matching nothing, usually a typo, is
+// reported the same way for all of them.
+func nameKeys(conf *Config) []configKeys {
+	return []configKeys{
+		{"DualStorage", conf.DualStorage},
+		{"ExpandArchives", conf.ExpandArchives},
+	}
+}
+
+// matchName reports whether the canonical name matches key, an exact name
+// or a path.Match pattern.
+func matchName(key, name string) (bool, error) {
+	if key == name {
+		return true, nil
+	}
+	return path.Match(key, name)
+}
+
+// matchKeys reports whether the canonical name matches one of the keys of
+// a Config field.
+func matchKeys(k configKeys, name string) (bool, error) {
+	for _, key := range k.Keys {
+		ok, err := matchName(key, name)
+		if err != nil {
+			return false, fmt.Errorf("%s %s: %v", k.Field, key, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// unmatchedKeys returns, by field, the keys of fields that match none of
+// names.
+func unmatchedKeys(fields []configKeys, names []string) (map[string][]string, error) {
+	unmatched := make(map[string][]string)
+	for _, f := range fields {
+	keys:
+		for _, key := range f.Keys {
+			for _, name := range names {
+				ok, err := matchName(key, name)
+				if err != nil {
+					return nil, fmt.Errorf("%s %s: %v", f.Field, key, err)
+				}
+				if ok {
+					continue keys
+				}
+			}
+			unmatched[f.Field] = append(unmatched[f.Field], key)
+		}
+	}
+	return unmatched, nil
+}
+
+// checkKeys reports the keys of name keyed Config fields that match none of
+// the names of p or of the expanded archives. With Config.StrictKeys they
+// are returned as one error, grouped by field, else warned about.
+func (p *Plan) checkKeys(archives []pendingArchive) error {
+	names := make([]string, 0, len(p.files)+len(p.dirs)+len(archives))
+	for _, f := range p.files {
+		names = append(names, f.Name)
+	}
+	for _, d := range p.dirs {
+		names = append(names, d.Name)
+	}
+	for _, a := range archives {
+		names = append(names, a.Name)
+	}
+	unmatched, err := unmatchedKeys(nameKeys(p.conf), names)
+	if err != nil || len(unmatched) == 0 {
+		return err
+	}
+	fields := make([]string, 0, len(unmatched))
+	for field := range unmatched {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	if !p.conf.StrictKeys {
+		for _, field := range fields {
+			for _, key := range unmatched[field] {
+				p.warn(WarningUnmatchedKey, key, "%s: %s matches no embedded file or directory", field, key)
+			}
+		}
+		return nil
+	}
+	msgs := make([]string, 0, len(fields))
+	for _, field := range fields {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", field, strings.Join(unmatched[field], ", ")))
+	}
+	return fmt.Errorf("keys match no embedded file or directory: %s", strings.Join(msgs, "; "))
+}
@@ -0,0 +1,59 @@
+package embed
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_unmatchedKeys(t *testing.T) {
+	names := []string{"/", "/css", "/css/main.css", "/index.html"}
+	got, err := unmatchedKeys([]configKeys{
+		{"A", []string{"/index.html", "/css/*.css", "/index.htm"}},
+		{"B", []string{"/css", "/js/*"}},
+		{"C", nil},
+	}, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{"A": {"/index.htm"}, "B": {"/js/*"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unmatchedKeys() = %v, want %v", got, want)
+	}
+	if _, err := unmatchedKeys([]configKeys{{"A", []string{"/[x"}}}, names); err == nil || !strings.Contains(err.Error(), "A /[x") {
+		t.Errorf("unmatchedKeys() error = %v, want a malformed pattern of A", err)
+	}
+}
+
+func TestCheckKeys(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"web/index.html": "<html></html>"})
+	writeZip(t, filepath.Join(root, "web", "lib.zip"), map[string]string{"lib.js": "lib()"})
+	conf := &Config{
+		Package:        "main",
+		Prefix:         root,
+		Files:          []string{root},
+		ExpandArchives: []string{"/web/lib.zip", "/web/lib.zp", "/vendor/*.tar"},
+		Warn:           func(string) {},
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unmatched []string
+	for _, w := range p.Warnings() {
+		if w.Code == WarningUnmatchedKey {
+			unmatched = append(unmatched, w.Path)
+		}
+	}
+	if want := []string{"/web/lib.zp", "/vendor/*.tar"}; !reflect.DeepEqual(unmatched, want) {
+		t.Errorf("unmatched key warnings for %v, want %v", unmatched, want)
+	}
+
+	conf.StrictKeys = true
+	_, err = Collect(conf)
+	if want := "ExpandArchives: /web/lib.zp, /vendor/*.tar"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Collect() error = %v, want %q", err, want)
+	}
+}
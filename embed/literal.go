@@ -0,0 +1,201 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// The templates do not render the base64 gzip data of files but a raw
+// string literal holding a line with gzipMarker and the index of the file
+// in Plan.files. writeSource replaces the markers while writing the
+// formatted source, so the encoded data is never held in memory but by the
+// output.
+const gzipMarker = "\x01"
+
+// base64LineLen is the length of the lines of base64 gzip data.
+const base64LineLen = 80
+
+// literalChunkSize is the size of the largest string literal written for
+// data, which is written as a sum of literals if it is larger. The compiler
+// and editors handle single literals of hundreds of megabytes poorly.
+var literalChunkSize = 1 << 20
+
+// chunkSeparator ends a raw string literal of base64 gzip data and starts
+// the next one.
+const chunkSeparator = "` + `"
+
+// base64ChunkLines returns the number of lines of base64 gzip data in a raw
+// string literal.
+func base64ChunkLines() int {
+	if n := literalChunkSize / (base64LineLen + 1); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// base64LiteralLen returns the length of the raw string literal content
+// written by writeBase64 for gzip data of size bytes.
+func base64LiteralLen(size int64) int64 {
+	n := int64(base64.StdEncoding.EncodedLen(int(size)))
+	lines := (n + base64LineLen - 1) / base64LineLen
+	chunks := (lines + int64(base64ChunkLines()) - 1) / int64(base64ChunkLines())
+	if chunks > 1 {
+		n += (chunks - 1) * int64(len(chunkSeparator)+1)
+	}
+	return 1 + n + lines
+}
+
+// writeBase64 writes the base64 encoding of the gzip data of f to w as the
+// content of a raw string literal: a newline and lines of base64LineLen,
+// split into literals of base64ChunkLines lines.
+func (f *_escFile) writeBase64(w io.Writer) error {
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+	lw := &lineWriter{w: w}
+	enc := base64.NewEncoder(base64.StdEncoding, lw)
+	if f.streamPath != "" {
+		if err := f.writeStreamed(enc); err != nil {
+			return err
+		}
+	} else if _, err := enc.Write(f.GzipData); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return lw.Close()
+}
+
+// lineWriter writes to w with a newline after every base64LineLen bytes,
+// and chunkSeparator and a newline after every base64ChunkLines lines.
+type lineWriter struct {
+	w     io.Writer
+	col   int
+	lines int
+}
+
+func (lw *lineWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		if lw.col == 0 && lw.lines == base64ChunkLines() {
+			if _, err := io.WriteString(lw.w, chunkSeparator+"\n"); err != nil {
+				return n, err
+			}
+			lw.lines = 0
+		}
+		k := base64LineLen - lw.col
+		if k > len(p) {
+			k = len(p)
+		}
+		m, err := lw.w.Write(p[:k])
+		n += m
+		if err != nil {
+			return n, err
+		}
+		p = p[k:]
+		if lw.col += k; lw.col == base64LineLen {
+			if _, err := io.WriteString(lw.w, "\n"); err != nil {
+				return n, err
+			}
+			lw.col = 0
+			lw.lines++
+		}
+	}
+	return n, nil
+}
+
+// Close ends the last line if it is not empty.
+func (lw *lineWriter) Close() error {
+	if lw.col == 0 {
+		return nil
+	}
+	lw.col = 0
+	_, err := io.WriteString(lw.w, "\n")
+	return err
+}
+
+// literalFuncs are the template functions writing data literals.
+var literalFuncs = template.FuncMap{"literal": quoteChunks}
+
+// quoteChunks returns data, a string or a byte slice, as a quoted string
+// literal, or as a sum of literals of literalChunkSize bytes at most if it
+// is longer. Chunks do not split UTF-8 sequences, which would be quoted
+// escaped.
+func quoteChunks(data interface{}) string {
+	var s string
+	switch data := data.(type) {
+	case string:
+		s = data
+	case []byte:
+		s = string(data)
+	default:
+		panic(fmt.Sprintf("literal of %T", data))
+	}
+	var b strings.Builder
+	for {
+		n := len(s)
+		if n > literalChunkSize {
+			n = literalChunkSize
+			for i := n; i > n-utf8.UTFMax && i > 0; i-- {
+				if utf8.RuneStart(s[i]) {
+					n = i
+					break
+				}
+			}
+		}
+		b.WriteString(strconv.Quote(s[:n]))
+		if s = s[n:]; s == "" {
+			return b.String()
+		}
+		b.WriteString(" +\n")
+	}
+}
+
+// writeSource writes src to w with the gzip markers replaced by the base64
+// gzip data of files.
+func writeSource(w io.Writer, src []byte, files []*_escFile) error {
+	bw := bufio.NewWriter(w)
+	marker := []byte("`\n" + gzipMarker)
+	for {
+		i := bytes.Index(src, marker)
+		if i < 0 {
+			break
+		}
+		start := i + len(marker)
+		end := bytes.IndexByte(src[start:], '\n')
+		if end < 0 {
+			return fmt.Errorf("unterminated gzip data marker at offset %d", i)
+		}
+		n, err := strconv.Atoi(string(src[start : start+end]))
+		if err != nil || n < 0 || n >= len(files) {
+			return fmt.Errorf("invalid gzip data marker %q", src[start:start+end])
+		}
+		if _, err := bw.Write(src[:i+1]); err != nil {
+			return err
+		}
+		if err := files[n].writeBase64(bw); err != nil {
+			return err
+		}
+		// Continue at the closing backtick.
+		src = src[start+end+1:]
+	}
+	if _, err := bw.Write(src); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// readAll reads r to the end into a slice allocated for size bytes, the
+// expected size, so large files are not copied while growing it.
+func readAll(r io.Reader, size int64) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, size+bytes.MinRead))
+	_, err := buf.ReadFrom(r)
+	return buf.Bytes(), err
+}
@@ -0,0 +1,112 @@
+package embed
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"go/format"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteSource(t *testing.T) {
+	files := []*_escFile{{GzipData: []byte("a")}, {GzipData: make([]byte, 120)}}
+	src := "a = `\n" + gzipMarker + "1\n`\nb = `\n" + gzipMarker + "0\n`\n"
+	var b strings.Builder
+	if err := writeSource(&b, []byte(src), files); err != nil {
+		t.Fatal(err)
+	}
+	want := "a = `\n" + strings.Repeat("A", 80) + "\n" + strings.Repeat("A", 80) + "\n`\nb = `\nYQ==\n`\n"
+	if b.String() != want {
+		t.Errorf("writeSource() wrote %q, want %q", b.String(), want)
+	}
+
+	for _, src := range []string{"`\n" + gzipMarker + "2\n`", "`\n" + gzipMarker + "x\n`", "`\n" + gzipMarker + "0"} {
+		if err := writeSource(&b, []byte(src), files); err == nil {
+			t.Errorf("writeSource(%q) succeeded, want an error", src)
+		}
+	}
+}
+
+func TestQuoteChunks(t *testing.T) {
+	defer func(n int) { literalChunkSize = n }(literalChunkSize)
+	literalChunkSize = 4
+	for _, tt := range []struct {
+		data interface{}
+		want string
+	}{
+		{"", `""`},
+		{"abcd", `"abcd"`},
+		{"abcdef", "\"abcd\" +\n\"ef\""},
+		{[]byte("abc\u00e9f"), "\"abc\" +\n\"\u00e9f\""},
+	} {
+		if got := quoteChunks(tt.data); got != tt.want {
+			t.Errorf("quoteChunks(%q) = %s, want %s", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestChunkedLiterals(t *testing.T) {
+	defer func(n int) { literalChunkSize = n }(literalChunkSize)
+	literalChunkSize = 3 * (base64LineLen + 1)
+	data := make([]byte, 4000)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	f := &_escFile{Data: data}
+	if err := f.fillCompressed(gzip.BestSpeed); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := f.writeBase64(&b); err != nil {
+		t.Fatal(err)
+	}
+	if n := base64LiteralLen(f.CompressedSize); n != int64(b.Len()) {
+		t.Errorf("base64LiteralLen() = %d, want %d", n, b.Len())
+	}
+	n := strings.Count(b.String(), chunkSeparator)
+	lines := strings.Count(b.String(), "\n") - 1 - n
+	if want := (lines - 1) / 3; n != want {
+		t.Errorf("writeBase64() wrote %d separators for %d lines, want %d", n, lines, want)
+	}
+
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"data.bin": string(data),
+		"hex.txt":  hex.EncodeToString(data),
+		"text.txt": strings.Repeat("\u00e9sc ", 500),
+	})
+	for _, encoding := range []string{EncodingBase64, EncodingString} {
+		conf := &Config{Package: "main", Prefix: root, Files: []string{root}, Encoding: encoding, DualStorage: []string{"/text.txt"}}
+		var buf bytes.Buffer
+		if err := Run(conf, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if formatted, err := format.Source(buf.Bytes()); err != nil || !bytes.Equal(formatted, buf.Bytes()) {
+			t.Errorf("%s: output is not gofmt formatted: %v", encoding, err)
+		}
+		// Quoting escapes a byte in up to 4.
+		for _, line := range strings.Split(buf.String(), "\n") {
+			if len(line) > 4*literalChunkSize+8 {
+				t.Fatalf("%s: output has a line of %d bytes", encoding, len(line))
+			}
+		}
+		runGenerated(t, conf, map[string]string{"static_test.go": `package main
+
+import "testing"
+
+func TestChunks(t *testing.T) {
+	if s := FSMustString(false, "/data.bin"); s != ` + strconv.Quote(string(data)) + ` {
+		t.Errorf("/data.bin differs")
+	}
+	if s := FSMustString(false, "/hex.txt"); s != "` + hex.EncodeToString(data) + `" {
+		t.Errorf("/hex.txt differs")
+	}
+	if s := FSMustString(false, "/text.txt"); s != ` + strconv.Quote(strings.Repeat("\u00e9sc ", 500)) + ` {
+		t.Errorf("/text.txt differs")
+	}
+}
+`}, "test", ".")
+	}
+}
@@ -0,0 +1,109 @@
+package embed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lookup modes for Config.LookupMode.
+const (
+	// LookupMap looks up embedded names in a map keyed by name.
+	LookupMap = "map"
+	// LookupBinarySearch looks up embedded names by binary search in sorted
+	// name slices, which saves the map and its keys in large outputs.
+	LookupBinarySearch = "binary-search"
+	// LookupCompact is LookupBinarySearch with all names and all local paths
+	// stored in one string each, which saves a string per name and path.
+	LookupCompact = "compact"
+)
+
+// checkLookupMode returns an error if mode is not a lookup mode.
+func checkLookupMode(mode string) error {
+	switch mode {
+	case "", LookupMap, LookupBinarySearch, LookupCompact:
+		return nil
+	}
+	return fmt.Errorf("unknown lookup mode %q, want %s, %s or %s", mode, LookupMap, LookupBinarySearch, LookupCompact)
+}
+
+// entryIndex maps the names of p to their index in the generated _escEntries
+// of LookupBinarySearch: files then directories, each sorted by name.
+func (p *Plan) entryIndex() map[string]int {
+	index := make(map[string]int, len(p.files)+len(p.dirs))
+	for i, f := range p.files {
+		index[f.Name] = i
+	}
+	for i, d := range p.dirs {
+		index[d.Name] = len(p.files) + i
+	}
+	return index
+}
+
+// compactLayout holds the names and local paths of the entries of a Plan
+// concatenated for LookupCompact.
+type compactLayout struct {
+	// NameBlob holds the names in _escEntries order, the name of the i-th
+	// entry from NameOffsets[i] to NameOffsets[i+1].
+	NameBlob    string
+	NameOffsets []int
+	// LocalBlob holds the local paths, LocalSpans maps names to the slice
+	// expression bounds of their local path in it, e.g. "10:24".
+	LocalBlob  string
+	LocalSpans map[string]string
+}
+
+func (p *Plan) compactLayout() *compactLayout {
+	var names, locals strings.Builder
+	c := &compactLayout{
+		NameOffsets: []int{0},
+		LocalSpans:  make(map[string]string, len(p.files)+len(p.dirs)),
+	}
+	add := func(name, local string) {
+		names.WriteString(name)
+		c.NameOffsets = append(c.NameOffsets, names.Len())
+		start := locals.Len()
+		locals.WriteString(local)
+		c.LocalSpans[name] = fmt.Sprintf("%d:%d", start, locals.Len())
+	}
+	for _, f := range p.files {
+		add(f.Name, f.Local)
+	}
+	for _, d := range p.dirs {
+		add(d.Name, d.Local)
+	}
+	c.NameBlob, c.LocalBlob = names.String(), locals.String()
+	return c
+}
+
+// foldedName maps the lower case of an embedded name to the name for
+// Config.CaseInsensitive.
+type foldedName struct {
+	Folded string
+	Name   string
+}
+
+// foldedNames returns the folded index of the files and directories of p,
+// sorted by folded name. It returns an error if two names differ in case
+// only, as a lookup could not tell them apart.
+func (p *Plan) foldedNames() ([]foldedName, error) {
+	names := make([]string, 0, len(p.files)+len(p.dirs))
+	for _, f := range p.files {
+		names = append(names, f.Name)
+	}
+	for _, d := range p.dirs {
+		names = append(names, d.Name)
+	}
+	folded := make([]foldedName, 0, len(names))
+	byFolded := make(map[string]string, len(names))
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		if other, ok := byFolded[lower]; ok {
+			return nil, fmt.Errorf("%s, %s: same name ignoring case", other, name)
+		}
+		byFolded[lower] = name
+		folded = append(folded, foldedName{Folded: lower, Name: name})
+	}
+	sort.Slice(folded, func(i, j int) bool { return folded[i].Folded < folded[j].Folded })
+	return folded, nil
+}
@@ -0,0 +1,337 @@
+package embed
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLookupModeUnknown(t *testing.T) {
+	_, err := Collect(&Config{Package: "main", LookupMode: "hash"})
+	if err == nil || !strings.Contains(err.Error(), `unknown lookup mode "hash"`) {
+		t.Errorf("Collect() error = %v, want unknown lookup mode", err)
+	}
+}
+
+// lookupProgram returns a main.go printing the outcome of opening, stating,
+// listing and relating to the first query every name in queries, and the
+// names of all files and directories.
+func lookupProgram(queries []string) string {
+	var b strings.Builder
+	b.WriteString(`package main
+
+import (
+	"fmt"
+	"os"
+)
+
+var queries = []string{
+`)
+	for _, q := range queries {
+		fmt.Fprintf(&b, "\t%s,\n", strconv.Quote(q))
+	}
+	b.WriteString(`}
+
+func main() {
+	for _, q := range queries {
+		fi, err := FSStat(q)
+		if err != nil {
+			fmt.Println(q, "stat:", os.IsNotExist(err))
+			continue
+		}
+		fmt.Println(q, "stat:", fi.Name(), fi.IsDir(), fi.Size())
+		rel, err := FSRelPath(q, queries[0])
+		fmt.Println(q, "relpath:", rel, err)
+		f, err := FS(false).Open(q)
+		if err != nil {
+			fmt.Println(q, "open:", err)
+			continue
+		}
+		if fi.IsDir() {
+			fis, err := f.Readdir(-1)
+			fmt.Print(q, " readdir: ", err)
+			for _, fi := range fis {
+				fmt.Print(" ", fi.Name())
+			}
+			fmt.Println()
+			continue
+		}
+		b, err := FSByte(false, q)
+		fmt.Println(q, "read:", string(b), err)
+	}
+	names := FSNames()
+	fmt.Println("names:", len(names), names[0], names[len(names)-1], "dirs:", FSDirNames())
+	restricted, err := FSRestricted(false, "/d1/*")
+	if err != nil {
+		fmt.Println("restricted:", err)
+		return
+	}
+	_, err = restricted.Open("/d2")
+	fmt.Println("restricted:", os.IsNotExist(err))
+}
+`)
+	return b.String()
+}
+
+func TestLookupModeEquivalence(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	root := t.TempDir()
+	files := make(map[string]string)
+	var names []string
+	for i := 0; i < 300; i++ {
+		name := fmt.Sprintf("d%d/s%d/f%03d.txt", rnd.Intn(5), rnd.Intn(5), i)
+		files[name] = fmt.Sprintf("content %d", i)
+		names = append(names, "/"+name)
+	}
+	writeTree(t, root, files)
+
+	var queries []string
+	for i := 0; i < 1000; i++ {
+		name := names[rnd.Intn(len(names))]
+		switch rnd.Intn(6) {
+		case 0:
+			queries = append(queries, path.Dir(name))
+		case 1:
+			queries = append(queries, name+".missing")
+		case 2:
+			queries = append(queries, strings.Replace(name, "/", "/./", 1))
+		case 3:
+			// A fingerprinted name is the base name with its version.
+			queries = append(queries, fingerprintName(name, contentHash([]byte(files[name[1:]]))[:versionLen]))
+		default:
+			queries = append(queries, name)
+		}
+	}
+	queries = append(queries, "/", "", "/d9", "/zzz")
+
+	outputs := make(map[string]string)
+	for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+		conf := &Config{
+			Package:     "main",
+			Prefix:      root,
+			Files:       []string{root},
+			Fingerprint: true,
+			LookupMode:  mode,
+		}
+		outputs[mode] = runGenerated(t, conf, map[string]string{"main.go": lookupProgram(queries)}, "run", ".")
+	}
+	for _, mode := range []string{LookupBinarySearch, LookupCompact} {
+		if got, want := outputs[mode], outputs[LookupMap]; got != want {
+			t.Errorf("%s lookups differ from map lookups:\n%s\nwant\n%s", mode, got, want)
+		}
+	}
+	if !strings.Contains(outputs[LookupMap], "names: 300 /d0/") || !strings.Contains(outputs[LookupMap], "dirs: [/ /d0 /d0/s0 ") {
+		t.Errorf("lookups listed no names:\n%s", outputs[LookupMap])
+	}
+	if !strings.Contains(outputs[LookupMap], "read: content") || !strings.Contains(outputs[LookupMap], "readdir: <nil> f") {
+		t.Errorf("lookups found no files or directories:\n%s", outputs[LookupMap])
+	}
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/index.html":   "index",
+		"web/Img/Logo.PNG": "logo",
+	})
+	queries := []string{"/img/logo.png", "/IMG/LOGO.png", "/Index.HTML", "/img", "/Img/Logo.PNG", "/img/logo.gif"}
+	for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+		conf := &Config{
+			Package:         "main",
+			Files:           []string{filepath.Join(root, "web")},
+			Prefix:          filepath.Join(root, "web"),
+			LookupMode:      mode,
+			CaseInsensitive: true,
+		}
+		out := runGenerated(t, conf, map[string]string{"main.go": lookupProgram(queries)}, "run", ".")
+		for _, want := range []string{
+			"/img/logo.png read: logo <nil>",
+			"/IMG/LOGO.png read: logo <nil>",
+			"/Index.HTML read: index <nil>",
+			"/img readdir: <nil> Logo.PNG",
+			"/img/logo.gif stat: true",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s lookups:\n%s\nwant %q", mode, out, want)
+			}
+		}
+	}
+
+	writeTree(t, root, map[string]string{"web/img/logo.png": "clash"})
+	conf := &Config{
+		Package:         "main",
+		Files:           []string{filepath.Join(root, "web")},
+		Prefix:          filepath.Join(root, "web"),
+		CaseInsensitive: true,
+	}
+	var out strings.Builder
+	if err := Run(conf, &out); err == nil || !strings.Contains(err.Error(), "same name ignoring case") {
+		t.Errorf("Run() with names differing in case only returned %v", err)
+	}
+}
+
+func TestFSNames(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/z.txt":         "z",
+		"web/a/b.txt":       "b",
+		"web/a.txt":         "a",
+		"web/B/c.txt":       "c",
+		"tmpl/index.html":   "index",
+		"dist/app/main.js":  "main",
+		"dist/app/main.css": "css",
+	})
+	if err := os.MkdirAll(filepath.Join(root, "web", "empty", "nested"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	wantNames := `["/B/c.txt" "/a.txt" "/a/b.txt" "/static/app/main.css" "/static/app/main.js" "/tmpl/index.html" "/z.txt"]`
+	wantDirs := `["/" "/B" "/a" "/empty" "/empty/nested" "/static" "/static/app" "/tmpl"]`
+	for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+		for _, conf := range []*Config{{}, {Private: true}, {FunctionPrefix: "Admin"}} {
+			prefix := conf.FunctionPrefix
+			if conf.Private {
+				prefix = defaultIdentPrefix
+			}
+			conf.Package = "main"
+			conf.LookupMode = mode
+			conf.Files = []string{filepath.Join(root, "web")}
+			conf.Prefix = filepath.Join(root, "web")
+			conf.Groups = []Group{{Name: "tmpl", Files: []string{filepath.Join(root, "tmpl")}, Prefix: filepath.Join(root, "tmpl")}}
+			conf.Mounts = []Mount{{Src: filepath.Join(root, "dist"), Dir: "/static"}}
+			program := strings.NewReplacer("PREFIX", prefix).Replace(`package main
+
+import "fmt"
+
+func main() {
+	names := PREFIXFSNames()
+	fmt.Printf("%q\n%q\n", names, PREFIXFSDirNames())
+	names[0] = "changed"
+	fmt.Println(PREFIXFSNames()[0])
+}
+`)
+			out := runGenerated(t, conf, map[string]string{"main.go": program}, "run", ".")
+			if want := wantNames + "\n" + wantDirs + "\n/B/c.txt\n"; out != want {
+				t.Errorf("%s with prefix %q: FSNames, FSDirNames =\n%s\nwant\n%s", mode, prefix, out, want)
+			}
+		}
+	}
+}
+
+var nsPerOp = regexp.MustCompile(`([0-9.]+) ns/op`)
+
+// BenchmarkLookupMode generates outputs with many files in each lookup mode
+// and reports the time of FSStat lookups in them as lookup-ns/op.
+func BenchmarkLookupMode(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		inline := make(map[string][]byte, n)
+		for i := 0; i < n; i++ {
+			inline[fmt.Sprintf("/d%03d/f%06d.txt", i%100, i)] = []byte("x")
+		}
+		for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+			b.Run(fmt.Sprintf("%s/%d", mode, n), func(b *testing.B) {
+				conf := &Config{
+					Package:       "main",
+					NoCompression: true,
+					InlineFiles:   inline,
+					LookupMode:    mode,
+				}
+				out := runGenerated(b, conf, map[string]string{"static_test.go": fmt.Sprintf(`package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func BenchmarkLookup(b *testing.B) {
+	names := make([]string, 1024)
+	for i := range names {
+		names[i] = fmt.Sprintf("/d%%03d/f%%06d.txt", i*%[1]d/1024%%100, i*%[1]d/1024)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := FSStat(names[i%%len(names)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+`, n)}, "test", "-run", "^$", "-bench", "Lookup")
+				m := nsPerOp.FindStringSubmatch(out)
+				if m == nil {
+					b.Fatalf("no ns/op in benchmark output:\n%s", out)
+				}
+				ns, err := strconv.ParseFloat(m[1], 64)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportMetric(ns, "lookup-ns/op")
+			})
+		}
+	}
+}
+
+// initAllocs returns the number of heap allocations made before main of a
+// program embedding n files, which are mostly those initializing the
+// generated tables.
+func initAllocs(tb testing.TB, mode string, n int) uint64 {
+	tb.Helper()
+	root := tb.TempDir()
+	files := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		files[fmt.Sprintf("d%02d/f%06d.txt", i%50, i)] = "x"
+	}
+	writeTree(tb, root, files)
+	conf := &Config{
+		Package:       "main",
+		Prefix:        root,
+		Files:         []string{root},
+		NoCompression: true,
+		LookupMode:    mode,
+	}
+	out := runGenerated(tb, conf, map[string]string{"main.go": `package main
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func main() {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	fmt.Println(ms.Mallocs)
+}
+`}, "run", ".")
+	allocs, err := strconv.ParseUint(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return allocs
+}
+
+func TestLookupModeInitAllocs(t *testing.T) {
+	const n = 1000
+	byMap := initAllocs(t, LookupMap, n)
+	for _, mode := range []string{LookupBinarySearch, LookupCompact} {
+		// The map allocates at least one entry per file at init.
+		if allocs := initAllocs(t, mode, n); allocs > byMap-n/2 {
+			t.Errorf("%s: %d allocations at init, want far fewer than the %d of map", mode, allocs, byMap)
+		}
+	}
+}
+
+// BenchmarkLookupModeInit reports the heap allocations made at init of
+// outputs with many files in each lookup mode as init-allocs.
+func BenchmarkLookupModeInit(b *testing.B) {
+	for _, n := range []int{1000, 10000} {
+		for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+			b.Run(fmt.Sprintf("%s/%d", mode, n), func(b *testing.B) {
+				b.ReportMetric(float64(initAllocs(b, mode, n)), "init-allocs")
+			})
+		}
+	}
+}
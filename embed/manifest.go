@@ -0,0 +1,62 @@
+package embed
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// jsonManifest is the inventory of the embedded assets written with
+// Config.JSONManifest.
+type jsonManifest struct {
+	Files []jsonManifestFile `json:"files"`
+	Dirs  []string           `json:"dirs"`
+}
+
+type jsonManifestFile struct {
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256,omitempty"`
+	ModTime     string `json:"modTime"`
+	ContentType string `json:"contentType,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// manifestFileName returns the name of the JSON manifest written next to
+// outputFile.
+func manifestFileName(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_manifest.json"
+}
+
+// jsonManifest returns the JSON manifest of p, files and directories sorted
+// by name and modification times in RFC 3339 and UTC, so manifests of
+// different versions diff line by line.
+func (p *Plan) jsonManifest() ([]byte, error) {
+	if p.conf.OutputFile == "" {
+		return nil, errors.New("JSON manifest requires an output file")
+	}
+	m := jsonManifest{
+		Files: make([]jsonManifestFile, 0, len(p.files)),
+		Dirs:  make([]string, 0, len(p.dirs)),
+	}
+	for _, f := range p.files {
+		m.Files = append(m.Files, jsonManifestFile{
+			Name:        f.Name,
+			Size:        f.Size,
+			SHA256:      f.SHA256,
+			ModTime:     time.Unix(f.ModTime, 0).UTC().Format(time.RFC3339),
+			ContentType: f.ContentType,
+			Fingerprint: f.Fingerprint,
+		})
+	}
+	for _, d := range p.dirs {
+		m.Dirs = append(m.Dirs, d.Name)
+	}
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "JSON manifest")
+	}
+	return append(b, '\n'), nil
+}
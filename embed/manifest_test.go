@@ -0,0 +1,56 @@
+package embed
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONManifest(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"index.html":  "<html></html>",
+		"css/app.css": "body{}",
+	})
+	output := filepath.Join(t.TempDir(), "static.go")
+	conf := &Config{
+		OutputFile:   output,
+		Package:      "main",
+		Prefix:       root,
+		Files:        []string{root},
+		ModTime:      "1700000000",
+		Fingerprint:  true,
+		JSONManifest: true,
+	}
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(output), "static_manifest.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m jsonManifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("%v:\n%s", err, b)
+	}
+	if len(m.Files) != 2 || len(m.Dirs) != 2 || m.Dirs[0] != "/" || m.Dirs[1] != "/css" {
+		t.Fatalf("manifest:\n%s\nwant 2 files and the directories / and /css", b)
+	}
+	want := jsonManifestFile{
+		Name:        "/css/app.css",
+		Size:        6,
+		SHA256:      contentHash([]byte("body{}")),
+		ModTime:     "2023-11-14T22:13:20Z",
+		ContentType: "text/css; charset=utf-8",
+		Fingerprint: fingerprintName("/css/app.css", contentHash([]byte("body{}"))[:versionLen]),
+	}
+	if m.Files[0] != want {
+		t.Errorf("manifest entry = %+v, want %+v", m.Files[0], want)
+	}
+
+	conf.OutputFile = ""
+	if err := Run(conf, ioutil.Discard); err == nil {
+		t.Error("Run() wrote a JSON manifest without an output file")
+	}
+}
@@ -0,0 +1,110 @@
+package embed
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// builtinMinifiers minify the media types the standard library can, for an
+// empty command in Config.Minifiers.
+var builtinMinifiers = map[string]func(b []byte) ([]byte, error){
+	"application/json":          compactJSON,
+	"application/manifest+json": compactJSON,
+}
+
+// compactJSON removes the insignificant space of the JSON document b.
+func compactJSON(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// checkMinifiers validates the Config of minifiers.
+func checkMinifiers(conf *Config) error {
+	if len(conf.Minifiers) == 0 {
+		return nil
+	}
+	if conf.MetadataOnly || conf.WrapEmbedVar != "" {
+		return errors.New("minifiers require embedded file contents")
+	}
+	for mediaType, command := range conf.Minifiers {
+		if command == "" && builtinMinifiers[mediaType] == nil {
+			return errors.Errorf("minifier for %s: no command and no built-in minifier", mediaType)
+		}
+		if strings.Contains(mediaType, ";") {
+			return errors.Errorf("minifier for %s: media type with parameters", mediaType)
+		}
+	}
+	return nil
+}
+
+// minify replaces the data of the files of files whose content type has a
+// minifier in conf with their minified form, before they are compressed.
+func minify(files []*_escFile, conf *Config) error {
+	for _, f := range files {
+		mediaType := strings.TrimSpace(strings.SplitN(f.ContentType, ";", 2)[0])
+		command, ok := conf.Minifiers[mediaType]
+		if !ok || f.Data == nil {
+			continue
+		}
+		if f.Brotli != nil {
+			return errors.Errorf("%s: cannot minify a file with a precompressed brotli variant", f.Name)
+		}
+		var b []byte
+		var err error
+		if command == "" {
+			b, err = builtinMinifiers[mediaType](f.Data)
+		} else {
+			b, err = runMinifier(command, f.Data, conf.WorkingDir)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "minify %s", f.Name)
+		}
+		f.setData(b, conf.Fingerprint)
+	}
+	return nil
+}
+
+// minifierCache holds the output of minifier commands by command, directory
+// and SHA-256 of their input, so Watch and QuickFingerprint run them once
+// for every content.
+var minifierCache = struct {
+	sync.Mutex
+	m map[string][]byte
+}{m: make(map[string][]byte)}
+
+// runMinifier runs command, split into fields without a shell, in dir with
+// b on its standard input and returns its standard output.
+func runMinifier(command string, b []byte, dir string) ([]byte, error) {
+	key := command + "\x00" + dir + "\x00" + contentHash(b)
+	minifierCache.Lock()
+	out, ok := minifierCache.m[key]
+	minifierCache.Unlock()
+	if ok {
+		return out, nil
+	}
+	args := strings.Fields(command)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(b)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Errorf("%s: %v: %s", command, err, msg)
+		}
+		return nil, errors.Errorf("%s: %v", command, err)
+	}
+	minifierCache.Lock()
+	minifierCache.m[key] = out
+	minifierCache.Unlock()
+	return out, nil
+}
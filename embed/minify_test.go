@@ -0,0 +1,74 @@
+package embed
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMinify(t *testing.T) {
+	if _, err := exec.LookPath("tr"); err != nil {
+		t.Skip("tr not found")
+	}
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/data.json": "{\n  \"a\": [1, 2]\n}\n",
+		"web/app.css":   "body { color: red }",
+		"web/index.txt": "kept as is",
+	})
+	conf := &Config{
+		Files:     []string{filepath.Join(root, "web")},
+		Prefix:    filepath.Join(root, "web"),
+		Minifiers: map[string]string{"application/json": "", "text/css": "tr a-z A-Z"},
+		Warn:      func(string) {},
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"/data.json": `{"a":[1,2]}`,
+		"/app.css":   "BODY { COLOR: RED }",
+		"/index.txt": "kept as is",
+	}
+	for _, f := range p.files {
+		if string(f.Data) != want[f.Name] || f.Size != int64(len(want[f.Name])) {
+			t.Errorf("%s = %q (%d bytes), want %q", f.Name, f.Data, f.Size, want[f.Name])
+		}
+		if f.SHA256 != contentHash(f.Data) {
+			t.Errorf("%s: sha256 of the data before minifying", f.Name)
+		}
+	}
+
+	for _, tc := range []struct {
+		minifiers map[string]string
+		want      string
+	}{
+		{map[string]string{"text/css": ""}, "no built-in minifier"},
+		{map[string]string{"text/css; charset=utf-8": "tr a-z A-Z"}, "media type with parameters"},
+		{map[string]string{"text/css": "false"}, "minify /app.css: false: exit status 1"},
+	} {
+		c := *conf
+		c.Minifiers = tc.minifiers
+		if _, err := Collect(&c); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("Collect() with Minifiers %v returned %v, want %q", tc.minifiers, err, tc.want)
+		}
+	}
+	// Commands run once for every content, e.g. while watching.
+	if _, err := exec.LookPath("tee"); err != nil {
+		return
+	}
+	log := filepath.Join(root, "minified.log")
+	c := *conf
+	c.Minifiers = map[string]string{"text/css": "tee -a " + log}
+	for i := 0; i < 2; i++ {
+		if _, err := QuickFingerprint(&c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b, err := ioutil.ReadFile(log); err != nil || string(b) != "body { color: red }" {
+		t.Errorf("minifier input log = %q, %v, want the file minified once", b, err)
+	}
+}
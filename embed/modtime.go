@@ -0,0 +1,203 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ModTimeGit is the Config.ModTime setting the modification time of every
+// file to the time of its last commit.
+const ModTimeGit = "git"
+
+// Keywords of Config.ModTime settings for a fixed time, which may be
+// followed by an offset such as "-24h" or "+1h30m".
+const (
+	// ModTimeNow is the time of the run.
+	ModTimeNow = "now"
+	// ModTimeBuild is the time of the last commit of the work tree of
+	// Config.WorkingDir, which unlike ModTimeNow is the same for every
+	// build of a commit.
+	ModTimeBuild = "build"
+)
+
+// timeNow is time.Now, replaced in tests.
+var timeNow = time.Now
+
+// modTimes sets the modification times of files as selected by
+// Config.ModTime.
+type modTimes struct {
+	// fixed is the time of all files, if set.
+	fixed *int64
+	// git is set with ModTimeGit.
+	git *gitTimes
+}
+
+// newModTimes parses the Config.ModTime setting, which defaults to the
+// SOURCE_DATE_EPOCH environment variable of reproducible builds. ModTimeBuild
+// is read from the work tree of dir.
+func newModTimes(setting, dir string) (*modTimes, error) {
+	if setting == "" {
+		setting = os.Getenv("SOURCE_DATE_EPOCH")
+	}
+	switch setting {
+	case "":
+		return &modTimes{}, nil
+	case ModTimeGit:
+		return &modTimes{git: &gitTimes{tops: make(map[string]string), trees: make(map[string]map[string]int64)}}, nil
+	}
+	i, err := parseModTime(setting, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &modTimes{fixed: &i}, nil
+}
+
+// parseModTime returns the Unix time of a Config.ModTime setting for a
+// fixed time: a Unix timestamp, an RFC 3339 time or a keyword with an
+// optional offset.
+func parseModTime(setting, dir string) (int64, error) {
+	if i, err := strconv.ParseInt(setting, 10, 64); err == nil {
+		return i, nil
+	}
+	if t, err := time.Parse(time.RFC3339, setting); err == nil {
+		return t.Unix(), nil
+	}
+	keyword, offset := setting, ""
+	if i := strings.IndexAny(setting, "+-"); i >= 0 {
+		keyword, offset = setting[:i], setting[i:]
+	}
+	var t int64
+	switch keyword {
+	case ModTimeNow:
+		t = timeNow().Unix()
+	case ModTimeBuild:
+		out, err := git(dir, "log", "-1", "--format=%ct")
+		if err != nil {
+			return 0, errors.Wrap(err, "modtime build")
+		}
+		if t, err = strconv.ParseInt(strings.TrimSpace(out), 10, 64); err != nil {
+			return 0, errors.Wrap(err, "modtime build")
+		}
+	default:
+		return 0, fmt.Errorf("modtime %q must be a Unix timestamp, an RFC 3339 time, %q or %q with an optional offset such as -24h, or %q", setting, ModTimeNow, ModTimeBuild, ModTimeGit)
+	}
+	if offset != "" {
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return 0, fmt.Errorf("modtime %q: invalid offset: %v", setting, err)
+		}
+		t += int64(d / time.Second)
+	}
+	return t, nil
+}
+
+// set sets the modification time of f, read from the local file fname,
+// reporting whether it is the modification time on disk because fname is
+// not committed.
+func (m *modTimes) set(f *_escFile, fname string) (uncommitted bool, err error) {
+	switch {
+	case m.fixed != nil:
+		f.ModTime = *m.fixed
+	case m.git != nil:
+		t, ok, err := m.git.time(fname)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return true, nil
+		}
+		f.ModTime = t
+	}
+	return false, nil
+}
+
+// gitTimes holds the times of the last commits of the files of git work
+// trees, read once per tree.
+type gitTimes struct {
+	// tops maps directories to the top level of their work tree.
+	tops map[string]string
+	// trees maps top levels to the commit times of their files by slash
+	// separated path relative to the top level.
+	trees map[string]map[string]int64
+}
+
+// time returns the time of the last commit of fname, and false if it has
+// none.
+func (g *gitTimes) time(fname string) (int64, bool, error) {
+	abs, err := filepath.Abs(fname)
+	if err != nil {
+		return 0, false, err
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Dir(abs))
+	if err != nil {
+		return 0, false, err
+	}
+	top, ok := g.tops[dir]
+	if !ok {
+		out, err := git(dir, "rev-parse", "--show-toplevel")
+		if err != nil {
+			return 0, false, err
+		}
+		top = strings.TrimSpace(out)
+		g.tops[dir] = top
+	}
+	times, ok := g.trees[top]
+	if !ok {
+		if times, err = commitTimes(top); err != nil {
+			return 0, false, err
+		}
+		g.trees[top] = times
+	}
+	rel, err := filepath.Rel(top, filepath.Join(dir, filepath.Base(abs)))
+	if err != nil {
+		return 0, false, err
+	}
+	t, ok := times[filepath.ToSlash(rel)]
+	return t, ok, nil
+}
+
+// commitTimes returns the times of the last commits of the files of the
+// work tree top.
+func commitTimes(top string) (map[string]int64, error) {
+	out, err := git(top, "-c", "core.quotePath=false", "log", "--format=@%ct", "--name-only", "--no-renames")
+	if err != nil {
+		return nil, err
+	}
+	times := make(map[string]int64)
+	var t int64
+	s := bufio.NewScanner(strings.NewReader(out))
+	for s.Scan() {
+		line := s.Text()
+		if strings.HasPrefix(line, "@") {
+			if t, err = strconv.ParseInt(line[1:], 10, 64); err != nil {
+				return nil, err
+			}
+		} else if _, seen := times[line]; line != "" && !seen {
+			// The log starts with the newest commit.
+			times[line] = t
+		}
+	}
+	return times, s.Err()
+}
+
+// git runs git with args in dir and returns its output.
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrapf(err, "git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return string(out), nil
+}
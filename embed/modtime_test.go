@@ -0,0 +1,124 @@
+package embed
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestModTimeSourceDateEpoch(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"a.txt": "a"})
+	conf := &Config{Files: []string{root}, Prefix: root, SkipModuleCheck: true}
+	t.Setenv("SOURCE_DATE_EPOCH", "1234")
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.files[0].ModTime; got != 1234 {
+		t.Errorf("ModTime with SOURCE_DATE_EPOCH = %d, want 1234", got)
+	}
+	conf.ModTime = "42"
+	if p, err = Collect(conf); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.files[0].ModTime; got != 42 {
+		t.Errorf("ModTime with Config.ModTime = %d, want 42", got)
+	}
+	t.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+	conf.ModTime = ""
+	if _, err := Collect(conf); err == nil {
+		t.Error("Collect() with an invalid SOURCE_DATE_EPOCH must err")
+	}
+}
+
+func TestParseModTime(t *testing.T) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	timeNow = func() time.Time { return time.Unix(100000, 0) }
+	for _, tt := range []struct {
+		setting string
+		want    int64
+	}{
+		{"42", 42},
+		{"-1", -1},
+		{"1970-01-02T00:00:00Z", 86400},
+		{"1970-01-02T00:00:00+01:00", 82800},
+		{"now", 100000},
+		{"now-24h", 13600},
+		{"now+1h30m", 105400},
+	} {
+		if got, err := parseModTime(tt.setting, ""); err != nil || got != tt.want {
+			t.Errorf("parseModTime(%q) = %d, %v, want %d", tt.setting, got, err, tt.want)
+		}
+	}
+	for _, setting := range []string{"yesterday", "now-1d", "now-", "2024-05-01", "later+1h"} {
+		if _, err := parseModTime(setting, ""); err == nil {
+			t.Errorf("parseModTime(%q) succeeded, want an error", setting)
+		}
+	}
+}
+
+func TestModTimeGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip(err)
+	}
+	root := t.TempDir()
+	commit := func(date string, files map[string]string) {
+		t.Helper()
+		writeTree(t, root, files)
+		for _, args := range [][]string{
+			{"add", "."},
+			{"-c", "user.name=esc", "-c", "user.email=esc@example.com", "commit", "-q", "-m", date},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = root
+			cmd.Env = append(os.Environ(), "GIT_COMMITTER_DATE="+date, "GIT_AUTHOR_DATE="+date)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("git %v: %v\n%s", args, err, out)
+			}
+		}
+	}
+	if out, err := exec.Command("git", "init", "-q", root).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	commit("@1000 +0000", map[string]string{"assets/a.txt": "a", "assets/b.txt": "b"})
+	commit("@2000 +0000", map[string]string{"assets/b.txt": "B"})
+	writeTree(t, root, map[string]string{"assets/new.txt": "new"})
+
+	var warnings []Warning
+	conf := &Config{
+		Files:           []string{filepath.Join(root, "assets")},
+		Prefix:          filepath.Join(root, "assets"),
+		ModTime:         ModTimeGit,
+		SkipModuleCheck: true,
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range p.files {
+		if want := map[string]int64{"/a.txt": 1000, "/b.txt": 2000}[f.Name]; want != 0 && f.ModTime != want {
+			t.Errorf("%s: ModTime = %d, want %d", f.Name, f.ModTime, want)
+		}
+	}
+	for _, w := range p.Warnings() {
+		if w.Code == WarningUncommitted {
+			warnings = append(warnings, w)
+		}
+	}
+	if len(warnings) != 1 || warnings[0].Path != "/new.txt" {
+		t.Errorf("uncommitted warnings = %+v, want one for /new.txt", warnings)
+	}
+
+	conf.ModTime, conf.WorkingDir = ModTimeBuild+"-1h", root
+	if p, err = Collect(conf); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range p.files {
+		if f.ModTime != 2000-3600 {
+			t.Errorf("%s: ModTime with %s = %d, want %d", f.Name, conf.ModTime, f.ModTime, 2000-3600)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package embed
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/modfile"
+)
+
+// findModule walks up from dir looking for a go.mod file. It returns the
+// directory containing go.mod and the declared module path. If no go.mod is
+// found, modDir is empty and no error is returned.
+func findModule(dir string) (modDir, modPath string, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+	for {
+		b, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			modPath = modfile.ModulePath(b)
+			if modPath == "" {
+				return "", "", fmt.Errorf("%s: no module directive", filepath.Join(dir, "go.mod"))
+			}
+			return dir, modPath, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// outputDir returns the directory the generated file is written to.
+func outputDir(conf *Config) (string, error) {
+	name, err := outputPath(conf)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(name), nil
+}
+
+// moduleImportPath returns the import path of the package in dir as derived
+// from the enclosing module. ok is false if dir is not inside a module.
+func moduleImportPath(dir string) (importPath string, ok bool, err error) {
+	modDir, modPath, err := findModule(dir)
+	if err != nil || modDir == "" {
+		return "", false, err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false, err
+	}
+	rel, err := filepath.Rel(modDir, abs)
+	if err != nil {
+		return "", false, err
+	}
+	return path.Join(modPath, filepath.ToSlash(rel)), true, nil
+}
+
+// checkImportPath validates conf.ImportPath against the module enclosing the
+// output directory, if any.
+func checkImportPath(conf *Config) error {
+	if conf.ImportPath == "" || conf.SkipModuleCheck {
+		return nil
+	}
+	dir, err := outputDir(conf)
+	if err != nil {
+		return err
+	}
+	discovered, ok, err := moduleImportPath(dir)
+	if err != nil {
+		return errors.Wrap(err, "module discovery")
+	}
+	if ok && discovered != conf.ImportPath {
+		return fmt.Errorf("import path %q does not match %q discovered from go.mod", conf.ImportPath, discovered)
+	}
+	return nil
+}
+
+// projectRoot returns the absolute directory that local paths given
+// absolutely are recorded relative to: conf.Root if set, else the directory
+// of the go.mod enclosing the output directory. It returns "" if there is no
+// such directory or conf.AbsolutePaths is set.
+func projectRoot(conf *Config) (string, error) {
+	if conf.AbsolutePaths {
+		return "", nil
+	}
+	if conf.Root != "" {
+		return filepath.Abs(conf.Root)
+	}
+	dir, err := outputDir(conf)
+	if err != nil {
+		return "", err
+	}
+	modDir, _, err := findModule(dir)
+	return modDir, err
+}
+
+// rootRelative returns fname relative to root, slash separated, if fname is
+// an absolute path inside root. Otherwise fname is returned as is.
+func rootRelative(root, fname string) string {
+	if root == "" || !filepath.IsAbs(fname) {
+		return filepath.ToSlash(fname)
+	}
+	rel, err := filepath.Rel(root, fname)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(fname)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// scrubInvocation rewrites absolute paths inside root found in the
+// arguments of invocation, including flag values, relative to root.
+func scrubInvocation(invocation, root string) string {
+	if root == "" || invocation == "" {
+		return invocation
+	}
+	args := strings.Split(invocation, " ")
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			if eq := strings.Index(arg, "="); eq > 0 {
+				args[i] = arg[:eq+1] + rootRelative(root, arg[eq+1:])
+			}
+			continue
+		}
+		args[i] = rootRelative(root, arg)
+	}
+	return strings.Join(args, " ")
+}
+
+// DefaultInvocationLimit is the length the invocation recorded in the output
+// is truncated to if Config.InvocationLimit is zero.
+const DefaultInvocationLimit = 1000
+
+// truncateInvocation shortens invocation to about limit bytes by eliding
+// trailing file arguments, the last files of its arguments, with a marker
+// counting them. Flags and the arguments before the files are always kept.
+// A negative limit disables truncation.
+func truncateInvocation(invocation string, files, limit int) string {
+	if limit == 0 {
+		limit = DefaultInvocationLimit
+	}
+	if limit < 0 || len(invocation) <= limit {
+		return invocation
+	}
+	args := strings.Split(invocation, " ")
+	first := len(args) - files
+	if first < 0 {
+		first = 0
+	}
+	// Only trailing arguments that are not flags are files.
+	for i := len(args) - 1; i >= first; i-- {
+		if strings.HasPrefix(args[i], "-") {
+			first = i + 1
+			break
+		}
+	}
+	n := len(strings.Join(args[:first], " "))
+	kept := first
+	for kept < len(args) && n+1+len(args[kept]) <= limit {
+		n += 1 + len(args[kept])
+		kept++
+	}
+	if kept == len(args) {
+		return invocation
+	}
+	return strings.Join(args[:kept], " ") + fmt.Sprintf(" … (+%d more args)", len(args)-kept)
+}
+
+// embedPath returns the path of fname in an embed.FS declared in the package
+// in dir, which is fname relative to dir, slash separated.
+func embedPath(dir, fname string) (string, error) {
+	abs, err := filepath.Abs(fname)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(dir, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: not inside %s, cannot be read from an embed.FS variable", fname, dir)
+	}
+	return filepath.ToSlash(rel), nil
+}
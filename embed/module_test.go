@@ -0,0 +1,208 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func Test_moduleImportPath(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"go.mod":                  "module example.com/assets\n\ngo 1.18\n",
+		"web/static/index.html":   "<html></html>",
+		"nested/go.mod":           "module example.com/nested\n",
+		"nested/pkg/static/a.txt": "a",
+	})
+	outside := t.TempDir()
+
+	tests := []struct {
+		name   string
+		dir    string
+		want   string
+		wantOk bool
+	}{
+		{"module root", root, "example.com/assets", true},
+		{"subdirectory", filepath.Join(root, "web", "static"), "example.com/assets/web/static", true},
+		{"nested module", filepath.Join(root, "nested", "pkg"), "example.com/nested/pkg", true},
+		{"no module", outside, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := moduleImportPath(tt.dir)
+			if err != nil {
+				t.Fatalf("%q. moduleImportPath() error = %v", tt.name, err)
+			}
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("%q. moduleImportPath() = %q, %v, want %q, %v", tt.name, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRunImportPath(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"go.mod":                "module example.com/assets\n\ngo 1.18\n",
+		"web/static/index.html": "<html></html>",
+	})
+	output := filepath.Join(root, "web", "static.go")
+	files := []string{filepath.Join(root, "web", "static")}
+
+	tests := []struct {
+		name       string
+		importPath string
+		skip       bool
+		wantErr    bool
+	}{
+		{"matching", "example.com/assets/web", false, false},
+		{"mismatch", "example.com/other/web", false, true},
+		{"mismatch skipped", "example.com/other/web", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			conf := &Config{
+				OutputFile:      output,
+				Package:         "web",
+				ImportPath:      tt.importPath,
+				SkipModuleCheck: tt.skip,
+				Files:           files,
+			}
+			err := Run(conf, &buf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%q. Run() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := `package web // import "` + tt.importPath + `"`
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%q. Run() output does not contain %s", tt.name, want)
+			}
+		})
+	}
+}
+
+func TestRunReproducibleAcrossRoots(t *testing.T) {
+	generate := func(root string, absolutePaths bool) string {
+		writeTree(t, root, map[string]string{
+			"go.mod":              "module example.com/assets\n\ngo 1.18\n",
+			"web/static/app.js":   "console.log(1)",
+			"web/static/css/a.cs": "a{}",
+		})
+		static := filepath.Join(root, "web", "static")
+		output := filepath.Join(root, "web", "static.go")
+		var buf bytes.Buffer
+		conf := &Config{
+			OutputFile:    output,
+			Package:       "web",
+			Prefix:        static,
+			ModTime:       "0",
+			AbsolutePaths: absolutePaths,
+			Invocation:    "-o " + output + " -prefix=" + static + " " + static,
+			Files:         []string{static},
+		}
+		if err := Run(conf, &buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	a, b := generate(t.TempDir(), false), generate(t.TempDir(), false)
+	if a != b {
+		t.Errorf("Run() output differs between checkout locations:\n%s\n%s", a, b)
+	}
+	if !strings.Contains(a, `"esc -o web/static.go -prefix=web/static web/static"`) {
+		t.Errorf("Run() invocation not relative to root:\n%s", a)
+	}
+	if !strings.Contains(a, "\"web/static/app.js\"") {
+		t.Errorf("Run() local path not relative to root:\n%s", a)
+	}
+
+	if generate(t.TempDir(), true) == generate(t.TempDir(), true) {
+		t.Errorf("Run() output with AbsolutePaths must contain the checkout location")
+	}
+}
+
+func Test_rootRelative(t *testing.T) {
+	root := filepath.FromSlash("/work/project")
+	tests := []struct {
+		name  string
+		fname string
+		want  string
+	}{
+		{"relative", filepath.FromSlash("static/a.js"), "static/a.js"},
+		{"inside root", filepath.FromSlash("/work/project/static/a.js"), "static/a.js"},
+		{"root itself", root, "."},
+		{"outside root", filepath.FromSlash("/work/other/a.js"), "/work/other/a.js"},
+		{"sibling with common prefix", filepath.FromSlash("/work/project2/a.js"), "/work/project2/a.js"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rootRelative(root, tt.fname); got != tt.want {
+				t.Errorf("%q. rootRelative() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_truncateInvocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		invocation string
+		files      int
+		limit      int
+		want       string
+	}{
+		{"short", "-o static.go a b", 2, 0, "-o static.go a b"},
+		{"elide files", "-o static.go aaa bbb ccc", 3, 16, "-o static.go aaa … (+2 more args)"},
+		{"keep flags", "-o static.go -pkg main aaa bbb", 2, 5, "-o static.go -pkg main … (+2 more args)"},
+		{"flag after files", "aaa bbb -private", 2, 5, "aaa bbb -private"},
+		{"unlimited", "-o static.go aaa bbb ccc", 3, -1, "-o static.go aaa bbb ccc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateInvocation(tt.invocation, tt.files, tt.limit); got != tt.want {
+				t.Errorf("%q. truncateInvocation() = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunLongInvocation(t *testing.T) {
+	root := t.TempDir()
+	tree := make(map[string]string)
+	var files []string
+	for i := 0; i < 500; i++ {
+		name := fmt.Sprintf("assets/file-with-a-long-name-%03d.txt", i)
+		tree[name] = name
+		files = append(files, filepath.Join(root, filepath.FromSlash(name)))
+	}
+	writeTree(t, root, tree)
+	conf := &Config{
+		Package:    "main",
+		Prefix:     root,
+		Root:       root,
+		Invocation: "-o static.go -pkg main -prefix " + root + " " + strings.Join(files, " "),
+		Files:      files,
+	}
+	var buf bytes.Buffer
+	if err := Run(conf, &buf); err != nil {
+		t.Fatal(err)
+	}
+	first := strings.SplitN(buf.String(), "\n", 2)[0]
+	if !regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`).MatchString(first) {
+		t.Errorf("first line %q is not a generated code marker", first)
+	}
+	if len(first) > DefaultInvocationLimit+100 {
+		t.Errorf("first line has %d bytes, want about %d", len(first), DefaultInvocationLimit)
+	}
+	if !strings.HasPrefix(first, `// Code generated by "esc -o static.go -pkg main -prefix . assets/file-with-a-long-name-000.txt`) ||
+		!strings.Contains(first, "more args)") {
+		t.Errorf("first line = %q, want flags and the first files followed by an elision marker", first)
+	}
+}
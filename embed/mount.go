@@ -0,0 +1,39 @@
+package embed
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Mount is a file or directory embedded in a directory of its own, e.g.
+// ./web/dist in /static, with Src removed from the names of the files
+// under it instead of Prefix.
+type Mount struct {
+	// Src is the file or directory to embed. A file is embedded in Dir
+	// under its base name.
+	Src string
+	// Dir is the slash separated directory, e.g. "/static", to embed Src
+	// in.
+	Dir string
+}
+
+// checkMounts returns an error if mounts do not name a source and a clean,
+// absolute directory, or if two of them share a directory.
+func checkMounts(mounts []Mount) error {
+	seen := make(map[string]bool, len(mounts))
+	for _, m := range mounts {
+		if m.Src == "" {
+			return errors.Errorf("mount %s has no source", m.Dir)
+		}
+		if !strings.HasPrefix(m.Dir, "/") || path.Clean(m.Dir) != m.Dir {
+			return errors.Errorf("mount %s of %s is not a clean directory starting with /", m.Dir, m.Src)
+		}
+		if seen[m.Dir] {
+			return errors.Errorf("duplicate mount %s", m.Dir)
+		}
+		seen[m.Dir] = true
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package embed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Unicode normalization forms for Config.Normalization.
+const (
+	// NormalizationNFC is normalization form C, canonical decomposition
+	// followed by canonical composition, which composes letters decomposed
+	// into a base letter and combining marks, as macOS writes file names,
+	// e.g. U+0065 U+0301 into U+00E9 for "é".
+	NormalizationNFC = "nfc"
+)
+
+// checkNormalization returns an error if form is not a normalization form.
+func checkNormalization(form string) error {
+	switch form {
+	case "", NormalizationNFC:
+		return nil
+	}
+	return fmt.Errorf("unknown normalization form %q, want %s", form, NormalizationNFC)
+}
+
+// normalizeNFC returns name in normalization form C.
+func normalizeNFC(name string) string {
+	return norm.NFC.String(name)
+}
+
+// normalizeNames renames files and dirs, and the children of dirs, to the
+// normalization form. It returns an error if two names normalize to the
+// same name.
+func normalizeNames(files []*_escFile, dirs []*_escDir, form string) error {
+	if form == "" {
+		return nil
+	}
+	seen := make(map[string]string, len(files)+len(dirs))
+	rename := func(name string) (string, error) {
+		normalized := normalizeNFC(name)
+		if other, ok := seen[normalized]; ok {
+			return "", fmt.Errorf("%s, %s: same name in %s", other, name, strings.ToUpper(form))
+		}
+		seen[normalized] = name
+		return normalized, nil
+	}
+	for _, f := range files {
+		name, err := rename(f.Name)
+		if err != nil {
+			return err
+		}
+		if name == f.Name {
+			continue
+		}
+		f.Name, f.BaseName = name, normalizeNFC(f.BaseName)
+		if f.Fingerprint != "" {
+			f.Fingerprint = fingerprintName(name, f.Version)
+		}
+	}
+	for _, d := range dirs {
+		name, err := rename(d.Name)
+		if err != nil {
+			return err
+		}
+		d.Name, d.BaseName = name, normalizeNFC(d.BaseName)
+		for i, c := range d.ChildFileNames {
+			d.ChildFileNames[i] = normalizeNFC(c)
+		}
+		sort.Strings(d.ChildFileNames)
+	}
+	return nil
+}
+
+// nfcTables holds the Unicode data the generated _escNFC normalizes names
+// with, restricted to the runes of the embedded names, as map entries.
+type nfcTables struct {
+	// Decompositions maps the runes decomposing into runes of the
+	// embedded names in normalization form D to their decomposition.
+	Decompositions []nfcEntry
+	// CombiningClasses maps the combining marks of the embedded names
+	// in normalization form D to their canonical combining class.
+	CombiningClasses []nfcEntry
+	// Compositions maps the rune pairs composing the embedded names to
+	// their composition.
+	Compositions []nfcEntry
+}
+
+// nfcEntry is an entry of a map of nfcTables.
+type nfcEntry struct {
+	Key   string
+	Value string
+}
+
+// nfcTables returns the Unicode data normalizing every name canonically
+// equivalent to a name of p, whatever the runes it is written with, to that
+// name, or nil if no rune decomposes into the runes of the names. Names
+// equivalent to none may normalize to anything but a name of p.
+func (p *Plan) nfcTables() *nfcTables {
+	var names []string
+	for _, f := range p.files {
+		names = append(names, f.Name)
+	}
+	for _, d := range p.dirs {
+		names = append(names, d.Name)
+	}
+	decomposed := make(map[rune]bool)
+	for _, name := range names {
+		for _, r := range norm.NFD.String(name) {
+			decomposed[r] = true
+		}
+	}
+	t := new(nfcTables)
+	for _, r := range decomposingRunes() {
+		d := []rune(norm.NFD.String(string(r)))
+		all := true
+		for _, c := range d {
+			all = all && decomposed[c]
+		}
+		if all {
+			t.Decompositions = append(t.Decompositions, nfcEntry{Key: fmt.Sprintf("%#x", r), Value: runeList(d)})
+		}
+	}
+	if len(t.Decompositions) == 0 {
+		return nil
+	}
+	classes := make(map[rune]uint8)
+	for r := range decomposed {
+		if c := norm.NFD.PropertiesString(string(r)).CCC(); c != 0 {
+			classes[r] = c
+		}
+	}
+	for _, r := range sortedRunes(classes) {
+		t.CombiningClasses = append(t.CombiningClasses, nfcEntry{Key: fmt.Sprintf("%#x", r), Value: fmt.Sprint(classes[r])})
+	}
+	compositions := make(map[[2]rune]rune)
+	for _, name := range names {
+		composeRunes([]rune(norm.NFD.String(name)), func(r rune) uint8 { return classes[r] }, func(pair [2]rune) (rune, bool) {
+			c := []rune(norm.NFC.String(string(pair[:])))
+			if len(c) != 1 {
+				return 0, false
+			}
+			compositions[pair] = c[0]
+			return c[0], true
+		})
+	}
+	pairs := make([][2]rune, 0, len(compositions))
+	for pair := range compositions {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return compositions[pairs[i]] < compositions[pairs[j]] })
+	for _, pair := range pairs {
+		t.Compositions = append(t.Compositions, nfcEntry{Key: runeList(pair[:]), Value: fmt.Sprintf("%#x", compositions[pair])})
+	}
+	return t
+}
+
+// composeRunes composes runes, in normalization form D, to normalization
+// form C in place with the canonical combining classes of class and the
+// primary compositions of compose, and returns them. The generated _escNFC
+// composes names the same way.
+func composeRunes(runes []rune, class func(rune) uint8, compose func([2]rune) (rune, bool)) []rune {
+	composed := runes[:0]
+	starter, last := -1, uint8(0)
+	for _, r := range runes {
+		c := class(r)
+		if starter >= 0 && (starter == len(composed)-1 || last != 0 && last < c) {
+			if r, ok := compose([2]rune{composed[starter], r}); ok {
+				composed[starter] = r
+				continue
+			}
+		}
+		if c == 0 {
+			starter = len(composed)
+		}
+		last = c
+		composed = append(composed, r)
+	}
+	return composed
+}
+
+var (
+	decomposingOnce sync.Once
+	decomposing     []rune
+)
+
+// decomposingRunes returns the runes that have a canonical decomposition in
+// ascending order.
+func decomposingRunes() []rune {
+	decomposingOnce.Do(func() {
+		for r := rune(utf8.RuneSelf); r <= unicode.MaxRune; r++ {
+			if r >= 0xd800 && r < 0xe000 {
+				continue
+			}
+			if isHangulSyllable(r) || norm.NFD.PropertiesString(string(r)).Decomposition() != nil {
+				decomposing = append(decomposing, r)
+			}
+		}
+	})
+	return decomposing
+}
+
+// isHangulSyllable reports whether r is a precomposed Hangul syllable, which
+// decomposes algorithmically without Unicode data.
+func isHangulSyllable(r rune) bool {
+	return r >= 0xac00 && r <= 0xd7a3
+}
+
+// runeList returns runes as a Go composite literal, e.g. {0x41, 0x30a}.
+func runeList(runes []rune) string {
+	s := make([]string, len(runes))
+	for i, r := range runes {
+		s[i] = fmt.Sprintf("%#x", r)
+	}
+	return "{" + strings.Join(s, ", ") + "}"
+}
+
+// sortedRunes returns the keys of m in ascending order.
+func sortedRunes(m map[rune]uint8) []rune {
+	runes := make([]rune, 0, len(m))
+	for r := range m {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
@@ -0,0 +1,90 @@
+package embed
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeNFC(t *testing.T) {
+	for name, want := range map[string]string{
+		"/index.html":             "/index.html",
+		"/cafe\u0301.png":         "/caf\u00e9.png",
+		"/caf\u00e9.png":          "/caf\u00e9.png",
+		"/u\u0308\u0304.txt":      "/\u01d6.txt",
+		"/\u1112\u1161\u11ab.txt": "/\ud55c.txt",
+		"/\u1100\u1161":           "/\uac00",
+		"/\u0301leading-mark.txt": "/\u0301leading-mark.txt",
+		"/\u0418\u0306.json":      "/\u0419.json",
+		"/\u304b\u3099.txt":       "/\u304c.txt",
+		"/\u212b.svg":             "/\u00c5.svg",
+		"/a\u0302\u0323":          "/\u1ead",
+		"/q\u0307\u0323":          "/q\u0323\u0307",
+	} {
+		if got := normalizeNFC(name); got != want {
+			t.Errorf("normalizeNFC(%+q) = %+q, want %+q", name, got, want)
+		}
+	}
+}
+
+func TestNormalization(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/cafe\u0301/menu\u0308.txt": "menu",
+		"web/index.html":                "index",
+		"web/\u304b\u3099.txt":          "ga",
+		"web/\u1ead.txt":                "a",
+		"web/\u00c5.svg":                "angstrom",
+		"web/K.txt":                     "kelvin",
+	})
+	queries := []string{
+		"/caf\u00e9/men\u00fc.txt", "/cafe\u0301/menu\u0308.txt", "/caf\u00e9", "/index.html",
+		"/\u304c.txt", "/a\u0302\u0323.txt", "/a\u0323\u0302.txt", "/\u1ea1\u0302.txt", "/\u212b.svg", "/A\u030a.svg", "/\u212a.txt",
+	}
+	for _, mode := range []string{LookupMap, LookupBinarySearch, LookupCompact} {
+		conf := &Config{
+			Package:       "main",
+			Files:         []string{filepath.Join(root, "web")},
+			Prefix:        filepath.Join(root, "web"),
+			LookupMode:    mode,
+			Normalization: NormalizationNFC,
+		}
+		out := runGenerated(t, conf, map[string]string{"main.go": lookupProgram(queries)}, "run", ".")
+		for _, want := range []string{
+			"/caf\u00e9/men\u00fc.txt read: menu <nil>",
+			"/cafe\u0301/menu\u0308.txt read: menu <nil>",
+			"/caf\u00e9 readdir: <nil> men\u00fc.txt",
+			"dirs: [/ /caf\u00e9]",
+			"/\u304c.txt read: ga <nil>",
+			"/a\u0302\u0323.txt read: a <nil>",
+			"/a\u0323\u0302.txt read: a <nil>",
+			"/\u1ea1\u0302.txt read: a <nil>",
+			"/\u212b.svg read: angstrom <nil>",
+			"/A\u030a.svg read: angstrom <nil>",
+			"/\u212a.txt read: kelvin <nil>",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s lookups:\n%s\nwant %q", mode, out, want)
+			}
+		}
+	}
+
+	writeTree(t, root, map[string]string{"web/caf\u00e9/men\u00fc.txt": "clash"})
+	conf := &Config{
+		Package:       "main",
+		Files:         []string{filepath.Join(root, "web")},
+		Prefix:        filepath.Join(root, "web"),
+		Normalization: NormalizationNFC,
+	}
+	var out strings.Builder
+	if err := Run(conf, &out); err == nil || !strings.Contains(err.Error(), "same name in NFC") {
+		t.Errorf("Run() with names normalizing to the same returned %v", err)
+	}
+}
+
+func TestNormalizationUnknown(t *testing.T) {
+	_, err := Collect(&Config{Package: "main", Normalization: "nfkd"})
+	if err == nil || !strings.Contains(err.Error(), `unknown normalization form "nfkd"`) {
+		t.Errorf("Collect() error = %v, want unknown normalization form", err)
+	}
+}
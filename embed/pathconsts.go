@@ -0,0 +1,67 @@
+package embed
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// pathConstant is a generated constant holding the canonical name of an
+// embedded file, see Config.PathConstants.
+type pathConstant struct {
+	Ident string
+	Name  string
+}
+
+// initialisms are the words written in upper case in the identifiers of
+// path constants, as in "FileIndexHTML": those of golint and common file
+// extensions.
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true, "CSV": true,
+	"DNS": true, "EOF": true, "GIF": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ICO": true, "ID": true, "IP": true, "JPG": true, "JS": true,
+	"JSON": true, "PDF": true, "PNG": true, "RPC": true, "SQL": true, "SSH": true,
+	"SVG": true, "TCP": true, "TLS": true, "TTL": true, "TXT": true, "UDP": true,
+	"UI": true, "UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true,
+	"WASM": true, "XML": true, "XSS": true, "YAML": true,
+}
+
+// pathConstants returns a constant for every embedded file of p, named
+// prefix+"File" and the words of its canonical name, e.g. FileCSSMainCSS
+// for "/css/main.css". It returns an error if two names make the same
+// identifier, so a constant never silently changes the file it names.
+func (p *Plan) pathConstants(prefix string) ([]pathConstant, error) {
+	consts := make([]pathConstant, 0, len(p.files))
+	byIdent := make(map[string]string, len(p.files))
+	for _, f := range p.files {
+		ident := prefix + "File" + identWords(f.Name)
+		if other, ok := byIdent[ident]; ok {
+			return nil, errors.Errorf("%s, %s: same path constant %s", other, f.Name, ident)
+		}
+		byIdent[ident] = f.Name
+		consts = append(consts, pathConstant{Ident: ident, Name: f.Name})
+	}
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Ident < consts[j].Ident })
+	return consts, nil
+}
+
+// identWords returns the words of name, separated by any character but
+// letters and digits, joined in camel case with initialisms upper cased.
+func identWords(name string) string {
+	var b strings.Builder
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
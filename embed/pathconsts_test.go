@@ -0,0 +1,54 @@
+package embed
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIdentWords(t *testing.T) {
+	for name, want := range map[string]string{
+		"/index.html":        "IndexHTML",
+		"/css/main.css":      "CSSMainCSS",
+		"/js/app-bundle.js":  "JSAppBundleJS",
+		"/404.html":          "404HTML",
+		"/img/logoDark.svg":  "ImgLogoDarkSVG",
+		"/data/ünits.json":   "DataÜnitsJSON",
+		"/templates/id_card": "TemplatesIDCard",
+	} {
+		if got := identWords(name); got != want {
+			t.Errorf("identWords(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestPathConstants(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/index.html":  "index",
+		"web/css/app.css": "css",
+	})
+	conf := &Config{
+		Package:       "main",
+		Files:         []string{filepath.Join(root, "web")},
+		Prefix:        filepath.Join(root, "web"),
+		PathConstants: true,
+	}
+	sources := map[string]string{"consts_test.go": `package main
+
+import "testing"
+
+func TestPathConstants(t *testing.T) {
+	t.Log("consts", FileIndexHTML, FSMustString(false, FileCSSAppCSS))
+}
+`}
+	if out := runGenerated(t, conf, sources, "test", "-v", "."); !strings.Contains(out, "consts /index.html css") {
+		t.Errorf("go test:\n%s\nwant the path constants", out)
+	}
+
+	writeTree(t, root, map[string]string{"web/index-html": "clash"})
+	var out strings.Builder
+	if err := Run(conf, &out); err == nil || !strings.Contains(err.Error(), "same path constant FileIndexHTML") {
+		t.Errorf("Run() with clashing path constants returned %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// patternSet is a list of regular expressions and globs. A string matches
+// the set if it matches any of them.
+type patternSet []interface{ MatchString(s string) bool }
+
+// glob is a doublestar glob compiled to a regular expression matching
+// slash separated paths.
+type glob struct {
+	re *regexp.Regexp
+}
+
+// MatchString reports whether the path s matches g.
+func (g glob) MatchString(s string) bool {
+	return g.re.MatchString(filepath.ToSlash(s))
+}
+
+// compileGlob compiles a glob matching whole paths. In it, * matches any
+// sequence of characters but /, ? any character but /, [...] a character
+// class, negated by a leading ! or ^, and a \ escapes the next character.
+// A ** path element matches zero or more path elements, so a trailing /**
+// also matches the directory itself, which lets an ignored directory be
+// skipped without walking it.
+func compileGlob(pattern string) (glob, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	elems := strings.Split(pattern, "/")
+	for i, elem := range elems {
+		last := i == len(elems)-1
+		if elem == "**" {
+			switch {
+			case len(elems) == 1:
+				b.WriteString(".*")
+			case last:
+				b.WriteString("(?:/.*)?")
+			case i == 0:
+				b.WriteString("(?:.*/)?")
+			default:
+				b.WriteString("(?:/.*)?/")
+			}
+			continue
+		}
+		if i > 0 && elems[i-1] != "**" {
+			b.WriteString("/")
+		}
+		for j := 0; j < len(elem); j++ {
+			switch c := elem[j]; c {
+			case '*':
+				b.WriteString("[^/]*")
+			case '?':
+				b.WriteString("[^/]")
+			case '\\':
+				j++
+				if j == len(elem) {
+					return glob{}, fmt.Errorf("glob %q: trailing \\", pattern)
+				}
+				b.WriteString(regexp.QuoteMeta(elem[j : j+1]))
+			case '[':
+				end := strings.IndexByte(elem[j+1:], ']')
+				if end < 0 {
+					return glob{}, fmt.Errorf("glob %q: unclosed [", pattern)
+				}
+				class := elem[j+1 : j+1+end]
+				if strings.HasPrefix(class, "!") {
+					class = "^" + class[1:]
+				}
+				b.WriteString("[" + class + "]")
+				j += end + 1
+			default:
+				b.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return glob{}, fmt.Errorf("glob %q: %v", pattern, err)
+	}
+	return glob{re}, nil
+}
+
+// MatchString reports whether s matches any pattern in ps.
+func (ps patternSet) MatchString(s string) bool {
+	for _, re := range ps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// patternFile records a pattern file used for generation, so changes to it
+// are visible in the output.
+type patternFile struct {
+	Kind string
+	Path string
+	Hash string
+}
+
+// compilePatterns compiles the inline pattern, if any, the globs and the
+// patterns in file, if any, into one set.
+func compilePatterns(inline string, globs []string, file string) (patternSet, *patternFile, error) {
+	var ps patternSet
+	if inline != "" {
+		re, err := regexp.Compile(inline)
+		if err != nil {
+			return nil, nil, err
+		}
+		ps = append(ps, re)
+	}
+	for _, pattern := range globs {
+		g, err := compileGlob(pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		ps = append(ps, g)
+	}
+	if file == "" {
+		return ps, nil, nil
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	fps, err := parsePatterns(file, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(b)
+	return append(ps, fps...), &patternFile{Path: file, Hash: hex.EncodeToString(sum[:])[:16]}, nil
+}
+
+// parsePatterns compiles one regular expression per line of b. Blank lines
+// and lines starting with # are skipped. Errors are reported with the file
+// name and line number.
+func parsePatterns(file string, b []byte) (patternSet, error) {
+	var ps patternSet
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; s.Scan(); line++ {
+		pattern := strings.TrimSpace(s.Text())
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", file, line, err)
+		}
+		ps = append(ps, re)
+	}
+	return ps, s.Err()
+}
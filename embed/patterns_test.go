@@ -0,0 +1,175 @@
+package embed
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunPatternFiles(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"static/index.html":  "<html></html>",
+		"static/app.js":      "app()",
+		"static/app.js.map":  "{}",
+		"static/.DS_Store":   "",
+		"static/vendor/x.js": "x()",
+		"ignore.txt": `# Maintained by the web team.
+
+\.map$
+
+/\.DS_Store$
+   /vendor/
+`,
+		"bad.txt": `# 1
+\.map$
+# 3
+
+# 5
+\.js$
+(unclosed
+`,
+	})
+	static := filepath.Join(root, "static")
+	names := func(p *Plan) string {
+		var names []string
+		for _, f := range p.files {
+			names = append(names, f.Name)
+		}
+		return strings.Join(names, " ")
+	}
+
+	p, err := Collect(&Config{
+		Prefix:     static,
+		Ignore:     `index`,
+		IgnoreFile: filepath.Join(root, "ignore.txt"),
+		Files:      []string{static},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := names(p); got != "/app.js" {
+		t.Errorf("Collect() with IgnoreFile embeds %s, want /app.js", got)
+	}
+
+	p, err = Collect(&Config{
+		Prefix:      static,
+		IncludeFile: filepath.Join(root, "ignore.txt"),
+		Files:       []string{static},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := names(p); got != "/.DS_Store /app.js.map /vendor/x.js" {
+		t.Errorf("Collect() with IncludeFile embeds %s", got)
+	}
+
+	_, err = Collect(&Config{IgnoreFile: filepath.Join(root, "bad.txt"), Files: []string{static}})
+	if err == nil || !strings.Contains(err.Error(), "bad.txt:7: ") {
+		t.Errorf("Collect() error = %v, want an error at bad.txt:7", err)
+	}
+
+	var before, after bytes.Buffer
+	conf := &Config{
+		Package:    "main",
+		Prefix:     static,
+		ModTime:    "0",
+		Root:       root,
+		IgnoreFile: filepath.Join(root, "ignore.txt"),
+		Files:      []string{static},
+	}
+	if err := Run(conf, &before); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(before.String(), "\n// ignore-file ignore.txt sha256:") {
+		t.Errorf("Run() output does not record the ignore file:\n%s", before.String())
+	}
+	writeTree(t, root, map[string]string{"ignore.txt": `\.map$` + "\n# changed\n"})
+	if err := Run(conf, &after); err != nil {
+		t.Fatal(err)
+	}
+	if before.String() == after.String() {
+		t.Errorf("Run() output does not change with the ignore file")
+	}
+}
+
+func TestCompileGlob(t *testing.T) {
+	tests := []struct {
+		glob  string
+		match []string
+		skip  []string
+	}{
+		{"*.map", []string{"app.map", ".map"}, []string{"js/app.map", "app.map.js"}},
+		{"**/*.map", []string{"app.map", "js/app.map", "/abs/js/app.map"}, []string{"app.js"}},
+		{"assets/**/test/**", []string{"assets/test", "assets/test/a.js", "assets/x/y/test/z/a.js"}, []string{"assets/tests/a.js", "x/assets/test"}},
+		{"**", []string{"a", "a/b"}, nil},
+		{"a/?.[jt]s", []string{"a/x.js", "a/y.ts"}, []string{"a/xy.js", "a/x.cs", "a//.js"}},
+		{"a/[!x].js", []string{"a/y.js"}, []string{"a/x.js"}},
+		{`a\*.js`, []string{"a*.js"}, []string{"ab.js"}},
+		{"a/**/b", []string{"a/b", "a/x/b"}, []string{"a/xb", "ab"}},
+	}
+	for _, tt := range tests {
+		g, err := compileGlob(tt.glob)
+		if err != nil {
+			t.Errorf("compileGlob(%q): %v", tt.glob, err)
+			continue
+		}
+		for _, s := range tt.match {
+			if !g.MatchString(s) {
+				t.Errorf("%q does not match %q", tt.glob, s)
+			}
+		}
+		for _, s := range tt.skip {
+			if g.MatchString(s) {
+				t.Errorf("%q matches %q", tt.glob, s)
+			}
+		}
+	}
+	for _, bad := range []string{"a[", `a\`} {
+		if _, err := compileGlob(bad); err == nil {
+			t.Errorf("compileGlob(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestGlobs(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"static/index.html":       "<html></html>",
+		"static/app.js":           "app()",
+		"static/app.js.map":       "{}",
+		"static/lib/x.js":         "x()",
+		"static/lib/x.js.map":     "{}",
+		"static/lib/test/x.js":    "test()",
+		"static/test/fixture.txt": "",
+	})
+	static := filepath.Join(root, "static")
+	collect := func(ignore, include []string) string {
+		t.Helper()
+		p, err := Collect(&Config{
+			Prefix:       static,
+			IgnoreGlobs:  ignore,
+			IncludeGlobs: include,
+			Files:        []string{static},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, f := range p.files {
+			names = append(names, f.Name)
+		}
+		for _, d := range p.dirs {
+			names = append(names, "dir:"+d.Name)
+		}
+		return strings.Join(names, " ")
+	}
+	prefix := filepath.ToSlash(static)
+	if got, want := collect([]string{"**/*.map", prefix + "/**/test/**"}, nil), "/app.js /index.html /lib/x.js dir:/ dir:/lib"; got != want {
+		t.Errorf("Collect() with IgnoreGlobs = %s, want %s", got, want)
+	}
+	if got, want := collect(nil, []string{"**/*.js"}), "/app.js /lib/test/x.js /lib/x.js dir:/ dir:/lib dir:/lib/test dir:/test"; got != want {
+		t.Errorf("Collect() with IncludeGlobs = %s, want %s", got, want)
+	}
+}
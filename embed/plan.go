@@ -0,0 +1,142 @@
+package embed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+)
+
+// Plan is the set of files and directories collected from a Config, ready
+// to be rendered.
+type Plan struct {
+	conf         *Config
+	root         string
+	files        []*_escFile
+	dirs         []*_escDir
+	patternFiles []patternFile
+	warnings     []Warning
+}
+
+// Node is a file or directory in the tree of embedded assets.
+type Node struct {
+	// Name is the canonical name, e.g. "/css/main.css".
+	Name  string
+	IsDir bool
+	// Size is the uncompressed size of a file; zero for directories.
+	Size int64
+	// ModTime is the Unix timestamp of a file; zero for directories.
+	ModTime  int64
+	Children []*Node
+}
+
+// Tree returns the embedded assets as a tree rooted at "/". Directories that
+// were not walked themselves, such as the ancestors of a Files entry, are
+// synthesized. Children are sorted by name.
+//
+// The generated FSTree function returns the same tree at runtime.
+func (p *Plan) Tree() *Node {
+	root := &Node{Name: "/", IsDir: true}
+	nodes := map[string]*Node{"/": root}
+	var dir func(name string) *Node
+	dir = func(name string) *Node {
+		if n, ok := nodes[name]; ok {
+			return n
+		}
+		n := &Node{Name: name, IsDir: true}
+		nodes[name] = n
+		parent := dir(path.Dir(name))
+		parent.Children = append(parent.Children, n)
+		return n
+	}
+	for _, d := range p.dirs {
+		dir(d.Name)
+	}
+	for _, f := range p.files {
+		if _, ok := nodes[f.Name]; ok {
+			continue
+		}
+		n := &Node{
+			Name:    f.Name,
+			Size:    f.Size,
+			ModTime: f.ModTime,
+		}
+		nodes[f.Name] = n
+		parent := dir(path.Dir(f.Name))
+		parent.Children = append(parent.Children, n)
+	}
+	for _, n := range nodes {
+		sort.Slice(n.Children, func(i, j int) bool { return n.Children[i].Name < n.Children[j].Name })
+	}
+	return root
+}
+
+// Walk calls fn for n and all of its descendants in depth-first order,
+// visiting children in sorted order. If fn returns an error, Walk stops and
+// returns it.
+func (n *Node) Walk(fn func(*Node) error) error {
+	if err := fn(n); err != nil {
+		return err
+	}
+	for _, c := range n.Children {
+		if err := c.Walk(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Fingerprint returns a hex encoded SHA-256 digest over everything that
+// determines the output rendered from p: the name, local path, size,
+// modification time and content hash of every file, the directories and
+// their children, the pattern files and the options of the Config that
+// affect the output. The digest is recorded in the header of the output.
+//
+// For the same version of esc, the fingerprint is stable across runs,
+// platforms and checkout locations as long as local paths are recorded
+// relative to the root, and it does not depend on compression. It changes
+// whenever the embedded set or the generated code could change. Fingerprints
+// computed by different versions of esc may differ for the same inputs.
+func (p *Plan) Fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "template %s\n", contentHash([]byte(fileTemplate+conformanceTemplate+examplesTemplate)))
+
+	// The location and selection of the inputs are covered by the entries
+	// below; the paths themselves depend on the checkout.
+	c := *p.conf
+	c.OutputFile, c.WorkingDir, c.Root, c.Files = "", "", "", nil
+	c.Prefix, c.Ignore, c.Include, c.IgnoreFile, c.IncludeFile = "", "", "", "", ""
+	c.IgnoreGlobs, c.IncludeGlobs = nil, nil
+	c.Groups, c.Mounts = nil, nil
+	for _, g := range p.conf.Groups {
+		c.Groups = append(c.Groups, Group{Name: g.Name})
+	}
+	for _, m := range p.conf.Mounts {
+		c.Mounts = append(c.Mounts, Mount{Dir: m.Dir})
+	}
+	c.SkipModuleCheck, c.Warn, c.CacheDir = false, nil, ""
+	c.MaxFileSize, c.MaxTotalSize, c.StrictSizes = 0, 0, false
+	c.Invocation = scrubInvocation(c.Invocation, p.root)
+	// Inline contents are covered by the file entries, and the key is only
+	// hashed as a digest so that it cannot be printed with the Config.
+	c.InlineFiles, c.EncryptionKey = nil, nil
+	fmt.Fprintf(h, "config %#v\n", c)
+	if len(p.conf.EncryptionKey) > 0 {
+		fmt.Fprintf(h, "key %s\n", contentHash(p.conf.EncryptionKey))
+	}
+
+	for _, pf := range p.patternFiles {
+		fmt.Fprintf(h, "pattern %q %q %s\n", pf.Kind, pf.Path, pf.Hash)
+	}
+	for _, f := range p.files {
+		fmt.Fprintf(h, "file %q %q %d %d %o %s\n", f.Name, f.Local, f.Size, f.ModTime, f.Mode, f.SHA256)
+		if f.Brotli != nil {
+			fmt.Fprintf(h, "brotli %q %s\n", f.Name, contentHash(f.Brotli))
+		}
+	}
+	for _, d := range p.dirs {
+		fmt.Fprintf(h, "dir %q %q %q\n", d.Name, d.Local, d.ChildFileNames)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,157 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func dumpTree(n *Node) string {
+	var b strings.Builder
+	n.Walk(func(n *Node) error {
+		fmt.Fprintf(&b, "%s dir=%t size=%d modtime=%d children=%d\n", n.Name, n.IsDir, n.Size, n.ModTime, len(n.Children))
+		return nil
+	})
+	return b.String()
+}
+
+func TestPlanTree(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/css/main.css":    "body{}",
+		"web/css/b/print.css": "@media print{}",
+		"web/index.html":      "<html></html>",
+		"web/Z.txt":           "upper",
+		"extra/robots.txt":    "User-agent: *",
+	})
+	conf := &Config{
+		Package: "main",
+		Prefix:  root,
+		ModTime: "1500000000",
+		Files: []string{
+			filepath.Join(root, "web"),
+			// A single file below a directory that is not walked itself.
+			filepath.Join(root, "extra", "robots.txt"),
+		},
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `/ dir=true size=0 modtime=0 children=2
+/extra dir=true size=0 modtime=0 children=1
+/extra/robots.txt dir=false size=13 modtime=1500000000 children=0
+/web dir=true size=0 modtime=0 children=3
+/web/Z.txt dir=false size=5 modtime=1500000000 children=0
+/web/css dir=true size=0 modtime=0 children=2
+/web/css/b dir=true size=0 modtime=0 children=1
+/web/css/b/print.css dir=false size=14 modtime=1500000000 children=0
+/web/css/main.css dir=false size=6 modtime=1500000000 children=0
+/web/index.html dir=false size=13 modtime=1500000000 children=0
+`
+	if got := dumpTree(p.Tree()); got != want {
+		t.Errorf("Plan.Tree() = \n%s, want \n%s", got, want)
+	}
+
+	got := runGenerated(t, conf, map[string]string{"main.go": `package main
+
+import "fmt"
+
+func dump(n *FSNode) {
+	fmt.Printf("%s dir=%t size=%d modtime=%d children=%d\n", n.Name, n.IsDir, n.Size, n.ModTime, len(n.Children))
+	for _, c := range n.Children {
+		dump(c)
+	}
+}
+
+func main() {
+	dump(FSTree())
+}
+`}, "run", ".")
+	if got != want {
+		t.Errorf("FSTree() = \n%s, want \n%s", got, want)
+	}
+}
+
+func TestPlanFingerprint(t *testing.T) {
+	files := map[string]string{
+		"web/index.html":   "<html></html>",
+		"web/css/main.css": "body{}",
+	}
+	setup := func(t *testing.T, edit func(root string, conf *Config)) string {
+		root := t.TempDir()
+		writeTree(t, root, files)
+		conf := &Config{
+			Package: "main",
+			Prefix:  root,
+			Root:    root,
+			ModTime: "1500000000",
+			Files:   []string{filepath.Join(root, "web")},
+		}
+		if edit != nil {
+			edit(root, conf)
+		}
+		p, err := Collect(conf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		quick, err := QuickFingerprint(conf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fp := p.Fingerprint(); fp != quick {
+			t.Errorf("Plan.Fingerprint() = %s, QuickFingerprint() = %s, want them equal", fp, quick)
+		}
+		return quick
+	}
+
+	base := setup(t, nil)
+	if len(base) != 64 {
+		t.Errorf("Fingerprint() = %q, want a hex SHA-256 digest", base)
+	}
+	stable := map[string]func(root string, conf *Config){
+		"other checkout": nil,
+		"working dir":    func(root string, conf *Config) { conf.WorkingDir = root },
+		"output file": func(root string, conf *Config) {
+			conf.OutputFile = filepath.Join(root, "static.go")
+		},
+	}
+	for name, edit := range stable {
+		if got := setup(t, edit); got != base {
+			t.Errorf("%s: Fingerprint() = %s, want %s", name, got, base)
+		}
+	}
+
+	sensitive := map[string]func(root string, conf *Config){
+		"content": func(root string, conf *Config) {
+			writeTree(t, root, map[string]string{"web/index.html": "<html>!</html>"})
+		},
+		"new file": func(root string, conf *Config) {
+			writeTree(t, root, map[string]string{"web/robots.txt": ""})
+		},
+		"rename": func(root string, conf *Config) {
+			conf.Prefix = filepath.Join(root, "web")
+		},
+		"modtime":        func(root string, conf *Config) { conf.ModTime = "1500000001" },
+		"ignore":         func(root string, conf *Config) { conf.Ignore = `\.css$` },
+		"no compression": func(root string, conf *Config) { conf.NoCompression = true },
+		"private":        func(root string, conf *Config) { conf.Private = true },
+		"package":        func(root string, conf *Config) { conf.Package = "static" },
+	}
+	for name, edit := range sensitive {
+		if got := setup(t, edit); got == base {
+			t.Errorf("%s: Fingerprint() = %s, want a different fingerprint", name, got)
+		}
+	}
+
+	withKey := func(b byte) string {
+		return setup(t, func(root string, conf *Config) {
+			conf.EncryptionKey = bytes.Repeat([]byte{b}, 32)
+		})
+	}
+	if a, b := withKey(7), withKey(8); a == base || a == b {
+		t.Errorf("Fingerprint() with keys = %s and %s, want them to differ from each other and %s", a, b, base)
+	}
+}
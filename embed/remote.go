@@ -0,0 +1,101 @@
+package embed
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// remoteScheme starts the entries of Config.Files that are downloaded.
+const remoteScheme = "https://"
+
+// httpClient downloads remote files.
+var httpClient = &http.Client{Timeout: time.Minute}
+
+// remoteCache holds the content of remote files by URL and SHA-256, which
+// cannot change, so Watch and QuickFingerprint download every file once.
+var remoteCache = struct {
+	sync.Mutex
+	m map[string][]byte
+}{m: make(map[string][]byte)}
+
+// isRemote reports whether the Config.Files entry fname is downloaded.
+func isRemote(fname string) bool {
+	return strings.HasPrefix(fname, remoteScheme)
+}
+
+// fetchRemote downloads the remote entries of files and returns their
+// contents by canonical name, which is given by the name parameter of the
+// fragment or else the base name of the URL. The content must match the
+// sha256 parameter of the fragment, e.g.
+// "https://cdn.example.com/lib.js#sha256=…&name=/vendor/lib.js".
+func fetchRemote(files []string) (map[string][]byte, error) {
+	contents := make(map[string][]byte)
+	for _, fname := range files {
+		if !isRemote(fname) {
+			continue
+		}
+		u, err := url.Parse(fname)
+		if err != nil {
+			return nil, err
+		}
+		params, err := url.ParseQuery(u.Fragment)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+		sum := params.Get("sha256")
+		if sum == "" {
+			return nil, fmt.Errorf("%s: no sha256 to pin the content to, add #sha256=<hex>", fname)
+		}
+		name := params.Get("name")
+		if name == "" {
+			name = path.Base(u.Path)
+		}
+		name = path.Join("/", name)
+		if _, ok := contents[name]; ok {
+			return nil, fmt.Errorf("%s, %s: duplicate Name of remote file", name, fname)
+		}
+		u.Fragment = ""
+		b, err := download(u.String(), sum)
+		if err != nil {
+			return nil, err
+		}
+		contents[name] = b
+	}
+	return contents, nil
+}
+
+// download returns the content of rawURL, which must have the hex encoded
+// SHA-256 sum.
+func download(rawURL, sum string) ([]byte, error) {
+	key := rawURL + " " + sum
+	remoteCache.Lock()
+	b, ok := remoteCache.m[key]
+	remoteCache.Unlock()
+	if ok {
+		return b, nil
+	}
+	resp, err := httpClient.Get(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", rawURL, resp.Status)
+	}
+	if b, err = ioutil.ReadAll(resp.Body); err != nil {
+		return nil, fmt.Errorf("%s: %v", rawURL, err)
+	}
+	if got := contentHash(b); got != strings.ToLower(sum) {
+		return nil, fmt.Errorf("%s: sha256 is %s, want %s", rawURL, got, sum)
+	}
+	remoteCache.Lock()
+	remoteCache.m[key] = b
+	remoteCache.Unlock()
+	return b, nil
+}
@@ -0,0 +1,60 @@
+package embed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRemoteFiles(t *testing.T) {
+	requests := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/npm/lib.js" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("lib()"))
+	}))
+	defer srv.Close()
+	defer func(c *http.Client) { httpClient = c }(httpClient)
+	httpClient = srv.Client()
+
+	sum := contentHash([]byte("lib()"))
+	files := func(fragment string) []string {
+		return []string{srv.URL + "/npm/lib.js#" + fragment}
+	}
+	for fragment, want := range map[string]string{
+		"sha256=" + sum:                       "/lib.js",
+		"sha256=" + sum + "&name=vendor/a.js": "/vendor/a.js",
+	} {
+		p, err := Collect(&Config{Package: "main", Files: files(fragment)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(p.files) != 1 || p.files[0].Name != want || string(p.files[0].Data) != "lib()" || p.files[0].Local != "" {
+			t.Errorf("Collect() with %s embedded %+v, want %s", fragment, p.files, want)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("downloaded %d times, want once", requests)
+	}
+
+	for fragment, want := range map[string]string{
+		"":                        "no sha256",
+		"sha256=" + sum[1:] + "0": "sha256 is",
+	} {
+		if _, err := Collect(&Config{Package: "main", Files: files(fragment)}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Collect() with %q = %v, want %q", fragment, err, want)
+		}
+	}
+	conf := &Config{Package: "main", Files: []string{srv.URL + "/missing.js#sha256=" + sum}}
+	if _, err := Collect(conf); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("Collect() of a missing URL = %v, want 404", err)
+	}
+	conf = &Config{Package: "main", Files: files("sha256=" + sum), InlineFiles: map[string][]byte{"lib.js": nil}}
+	if _, err := Collect(conf); err == nil {
+		t.Error("Collect() with a remote and an inline file of the same name must err")
+	}
+}
@@ -0,0 +1,63 @@
+package embed
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// FileStats describes what an embedded file adds to the output.
+type FileStats struct {
+	Name string
+	// Size is the uncompressed size of the file.
+	Size int64
+	// EmbeddedSize is the number of bytes embedded for the file, before
+	// base64 encoding: its gzip data, its content if it is embedded
+	// uncompressed as well or only, and its brotli variant.
+	EmbeddedSize int64
+}
+
+// FileStats returns the FileStats of the files of p, largest EmbeddedSize
+// first, then by name.
+func (p *Plan) FileStats() []FileStats {
+	stats := make([]FileStats, 0, len(p.files))
+	for _, f := range p.files {
+		s := FileStats{Name: f.Name, Size: f.Size}
+		if !p.conf.MetadataOnly && p.conf.WrapEmbedVar == "" {
+			s.EmbeddedSize = f.CompressedSize + int64(len(f.Brotli))
+			if f.Dual || f.Stored {
+				s.EmbeddedSize += f.Size
+			}
+		}
+		stats = append(stats, s)
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].EmbeddedSize > stats[j].EmbeddedSize
+	})
+	return stats
+}
+
+// WriteReport writes a table of the files of r to w with their size,
+// embedded size and the ratio of both, largest contribution first, and the
+// totals.
+func (r *RunResult) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tSIZE\tEMBEDDED\tRATIO")
+	var size, embedded int64
+	for _, f := range r.Files {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", f.Name, f.Size, f.EmbeddedSize, ratio(f.EmbeddedSize, f.Size))
+		size += f.Size
+		embedded += f.EmbeddedSize
+	}
+	fmt.Fprintf(tw, "total, %d files\t%d\t%d\t%s\n", len(r.Files), size, embedded, ratio(embedded, size))
+	return tw.Flush()
+}
+
+// ratio returns n as a percentage of of, or "-" if of is zero.
+func ratio(n, of int64) string {
+	if of == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(of))
+}
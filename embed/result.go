@@ -0,0 +1,171 @@
+package embed
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// WarningCode identifies the kind of a Warning. Its values are stable.
+type WarningCode string
+
+// Warning codes.
+const (
+	// WarningNoFiles is reported when no file is embedded.
+	WarningNoFiles WarningCode = "no-files"
+	// WarningPrefixUnmatched is reported when Prefix matches none of the
+	// embedded files.
+	WarningPrefixUnmatched WarningCode = "prefix-unmatched"
+	// WarningDotfile is reported for every embedded file with a path
+	// element starting with a dot, such as "/.env" or "/.git/config".
+	WarningDotfile WarningCode = "dotfile"
+	// WarningLargeFile is reported for every file larger than a 32-bit
+	// platform can hold in memory.
+	WarningLargeFile WarningCode = "large-file"
+	// WarningUnmatchedKey is reported for every key of a Config field
+	// naming embedded files, such as ExpandArchives, that matches none.
+	WarningUnmatchedKey WarningCode = "unmatched-key"
+	// WarningUncommitted is reported for every file without a commit when
+	// Config.ModTime is ModTimeGit, which has its modification time on disk.
+	WarningUncommitted WarningCode = "uncommitted"
+	// WarningFileSize is reported for every file larger than
+	// Config.MaxFileSize.
+	WarningFileSize WarningCode = "file-size"
+	// WarningTotalSize is reported when the files are larger than
+	// Config.MaxTotalSize together.
+	WarningTotalSize WarningCode = "total-size"
+)
+
+// Warning is a problem found while collecting files that does not stop
+// generation.
+type Warning struct {
+	Code WarningCode
+	// Path is the canonical name the warning is about, if any.
+	Path    string
+	Message string
+}
+
+func (w Warning) String() string {
+	return w.Message
+}
+
+// Stats summarizes the embedded assets.
+type Stats struct {
+	Files int
+	Dirs  int
+	// Size is the total uncompressed size of the files.
+	Size int64
+	// CompressedSize is the total size of the gzip data embedded for the
+	// files, before base64 encoding. It is zero if no data is embedded.
+	// Large files are only compressed while the output is written, so
+	// Plan.Stats counts them once it is.
+	CompressedSize int64
+	// DualSize is the total size of the files embedded uncompressed as well
+	// as compressed, see Config.DualStorage, which is added to the output.
+	DualSize int64
+	// StoredSize is the total size of the files embedded uncompressed only,
+	// as compressing them would not make the output smaller.
+	StoredSize int64
+}
+
+// RunResult describes a successful Run.
+type RunResult struct {
+	Warnings []Warning
+	Stats    Stats
+	// Files describes the embedded files, see Plan.FileStats.
+	Files []FileStats
+	// Fingerprint is the fingerprint of the Plan, see Plan.Fingerprint.
+	Fingerprint string
+}
+
+// RunWithResult executes a Config like Run and also returns the warnings,
+// statistics and fingerprint of the generated output.
+func RunWithResult(conf *Config, out io.Writer) (res *RunResult, err error) {
+	return runWithResult(conf, nil, out)
+}
+
+// RunFS is Run embedding the files and directories of fsys, e.g. an
+// fstest.MapFS or a zip.Reader, under "/" in addition to those of conf. They
+// have no local path, so like the members of expanded archives they are
+// served from the embedded data also in local mode, and their modification
+// times are only overridden by a fixed Config.ModTime.
+func RunFS(conf *Config, fsys fs.FS, out io.Writer) error {
+	_, err := runWithResult(conf, fsys, out)
+	return err
+}
+
+// runWithResult implements RunWithResult and RunFS.
+func runWithResult(conf *Config, fsys fs.FS, out io.Writer) (res *RunResult, err error) {
+	var c cleanups
+	defer c.runUnless(&err)
+	p, err := collect(conf, fsys, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.render(out, &c); err != nil {
+		return nil, err
+	}
+	return &RunResult{
+		Warnings:    p.Warnings(),
+		Stats:       p.Stats(),
+		Files:       p.FileStats(),
+		Fingerprint: p.Fingerprint(),
+	}, nil
+}
+
+// Warnings returns the warnings found while collecting p. Warnings about
+// files are ordered by name.
+func (p *Plan) Warnings() []Warning {
+	return append([]Warning(nil), p.warnings...)
+}
+
+// Stats returns statistics about the files and directories of p.
+func (p *Plan) Stats() Stats {
+	s := Stats{Files: len(p.files), Dirs: len(p.dirs)}
+	for _, f := range p.files {
+		s.Size += f.Size
+		s.CompressedSize += f.CompressedSize
+		if f.Dual {
+			s.DualSize += f.Size
+		}
+		if f.Stored {
+			s.StoredSize += f.Size
+		}
+	}
+	return s
+}
+
+// warn records a warning and reports it through Config.Warn, if set.
+func (p *Plan) warn(code WarningCode, path, format string, args ...interface{}) {
+	w := Warning{Code: code, Path: path, Message: fmt.Sprintf(format, args...)}
+	p.warnings = append(p.warnings, w)
+	if p.conf.Warn != nil {
+		p.conf.Warn(w.Message)
+	}
+}
+
+// checkWarnings records the warnings about the collected files.
+func (p *Plan) checkWarnings(prefixUnmatched bool) {
+	if len(p.files) == 0 {
+		p.warn(WarningNoFiles, "", "no files are embedded")
+	}
+	if prefixUnmatched {
+		p.warn(WarningPrefixUnmatched, "", "prefix %q matches none of the embedded files", p.conf.Prefix)
+	}
+	for _, f := range p.files {
+		if strings.Contains(f.Name, "/.") {
+			p.warn(WarningDotfile, f.Name, "%s: dotfile is embedded", f.Name)
+		}
+	}
+	for _, f := range p.files {
+		if f.uncommitted {
+			p.warn(WarningUncommitted, f.Name, "%s: not committed, its modification time on disk is embedded", f.Name)
+		}
+	}
+	for _, f := range p.files {
+		if f.Size > maxSize32 {
+			p.warn(WarningLargeFile, f.Name, "%s: %d bytes are more than a 32-bit platform can hold in memory", f.Name, f.Size)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package embed
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestRunWithResult(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"web/.env":        "SECRET=1",
+		"web/.git/config": "[core]",
+		"web/index.html":  "<html></html>",
+	})
+	var messages []string
+	conf := &Config{
+		Package: "main",
+		Prefix:  filepath.Join(root, "elsewhere"),
+		Files:   []string{filepath.Join(root, "web")},
+		Warn:    func(msg string) { messages = append(messages, msg) },
+	}
+	var buf bytes.Buffer
+	res, err := RunWithResult(conf, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type codePath struct {
+		Code WarningCode
+		Path string
+	}
+	var got []codePath
+	for _, w := range res.Warnings {
+		got = append(got, codePath{w.Code, w.Path})
+	}
+	webPrefix := filepath.ToSlash(filepath.Join(root, "web"))
+	want := []codePath{
+		{WarningPrefixUnmatched, ""},
+		{WarningDotfile, webPrefix + "/.env"},
+		{WarningDotfile, webPrefix + "/.git/config"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RunWithResult() warnings = %v, want %v", got, want)
+	}
+	if len(messages) != len(res.Warnings) {
+		t.Errorf("Config.Warn got %d messages, want %d", len(messages), len(res.Warnings))
+	}
+	// The files are too small for gzip to make them smaller.
+	if res.Stats.Files != 3 || res.Stats.Dirs != 2 || res.Stats.Size != 27 || res.Stats.CompressedSize != 0 || res.Stats.StoredSize != 27 {
+		t.Errorf("RunWithResult() stats = %+v", res.Stats)
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Fingerprint != p.Fingerprint() || !bytes.Contains(buf.Bytes(), []byte(res.Fingerprint)) {
+		t.Errorf("RunWithResult() fingerprint = %s, want %s recorded in the output", res.Fingerprint, p.Fingerprint())
+	}
+
+	conf = &Config{Package: "main", Ignore: `\.html$`, Files: []string{filepath.Join(root, "web", "index.html")}, Warn: func(string) {}}
+	res, err = RunWithResult(conf, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Warnings) != 1 || res.Warnings[0].Code != WarningNoFiles {
+		t.Errorf("RunWithResult() with no files warnings = %v, want %s", res.Warnings, WarningNoFiles)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"a.txt":   strings.Repeat("a", 1000),
+		"b.txt":   "b",
+		"empty":   "",
+		"big.txt": strings.Repeat("esc embeds files\n", 1000),
+	})
+	conf := &Config{Package: "main", Prefix: root, Files: []string{root}, DualStorage: []string{"/a.txt"}}
+	res, err := RunWithResult(conf, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range res.Files {
+		names = append(names, f.Name)
+	}
+	if want := []string{"/a.txt", "/big.txt", "/b.txt", "/empty"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Files by embedded size = %v, want %v", names, want)
+	}
+	if f := res.Files[0]; f.EmbeddedSize != 1000+res.Stats.CompressedSize-res.Files[1].EmbeddedSize {
+		t.Errorf("dual file EmbeddedSize = %d, want its size and gzip data", f.EmbeddedSize)
+	}
+
+	var buf bytes.Buffer
+	if err := res.WriteReport(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 6 || !strings.HasPrefix(lines[0], "FILE") || !strings.HasPrefix(lines[1], "/a.txt") {
+		t.Fatalf("WriteReport() wrote\n%s", buf.String())
+	}
+	for _, want := range []string{"/b.txt  ", "100.0%", "/empty", "-", "total, 4 files  18001"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("WriteReport() output lacks %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRunFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html": {Data: []byte("<html></html>"), ModTime: time.Unix(1500000000, 0)},
+		"css/a.css":  {Data: []byte("body{}"), Mode: 0600},
+	}
+	var buf bytes.Buffer
+	conf := &Config{Package: "main", Warn: func(string) {}}
+	if err := RunFS(conf, fsys, &buf); err != nil {
+		t.Fatal(err)
+	}
+	sources := map[string]string{
+		"static.go": buf.String(),
+		"static_test.go": `package main
+
+import (
+	"io/fs"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	for _, useLocal := range []bool{false, true} {
+		var names []string
+		err := fs.WalkDir(IOFS(useLocal), ".", func(name string, d fs.DirEntry, err error) error {
+			names = append(names, name)
+			return err
+		})
+		fi, _ := FSStat("/index.html")
+		t.Log("fs", names, err, FSMustString(useLocal, "/css/a.css"), fi.ModTime().Unix())
+	}
+}
+`,
+	}
+	want := "fs [. css css/a.css index.html] <nil> body{} 1500000000"
+	if out := runGenerated(t, conf, sources, "test", "-v", "."); strings.Count(out, want) != 2 {
+		t.Errorf("go test:\n%s\nwant %q in both modes", out, want)
+	}
+
+	conf.InlineFiles = map[string][]byte{"/index.html": nil}
+	if err := RunFS(conf, fsys, ioutil.Discard); err == nil {
+		t.Error("RunFS() with a file colliding with an inline file must err")
+	}
+}
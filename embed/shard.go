@@ -0,0 +1,122 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var shardTmpl = template.Must(template.New("").Funcs(literalFuncs).Parse(shardTemplate))
+
+// shardFileName returns the name of shard i written next to outputFile.
+func shardFileName(outputFile string, i int) string {
+	return strings.TrimSuffix(outputFile, ".go") + fmt.Sprintf("_%03d.go", i)
+}
+
+// shardFiles returns the shards written next to outputFile by earlier runs.
+func shardFiles(outputFile string) ([]string, error) {
+	return filepath.Glob(strings.TrimSuffix(outputFile, ".go") + "_[0-9][0-9][0-9].go")
+}
+
+// checkShards validates the Config of a sharded output.
+func checkShards(conf *Config) error {
+	switch {
+	case conf.ShardSize <= 0:
+		return nil
+	case conf.OutputFile == "":
+		return errors.New("sharding requires an output file to write the shards next to")
+	case conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed:
+		return errors.New("sharding requires embedded file contents")
+	case conf.Encoding == EncodingPacked || conf.Encoding == EncodingSidecar:
+		return fmt.Errorf("the %s encoding cannot be sharded", conf.Encoding)
+	}
+	return nil
+}
+
+// shards splits the files of p into groups holding data literals of at most
+// Config.ShardSize bytes, except for files larger than that, which are
+// alone in their group.
+func (p *Plan) shards(stringEncoding bool) [][]*_escFile {
+	var shards [][]*_escFile
+	var size int64
+	for _, f := range p.files {
+		var n int64
+		if !f.Stored {
+			n = base64LiteralLen(f.CompressedSize)
+		}
+		if stringEncoding {
+			n = int64(len(strconv.Quote(string(f.GzipData))))
+		}
+		if f.Dual || f.Stored {
+			n += int64(len(strconv.Quote(string(f.Data))))
+		}
+		n += int64(len(strconv.Quote(string(f.Brotli))))
+		if len(shards) == 0 || size > 0 && size+n > p.conf.ShardSize {
+			shards = append(shards, nil)
+			size = 0
+		}
+		shards[len(shards)-1] = append(shards[len(shards)-1], f)
+		size += n
+	}
+	return shards
+}
+
+// shardSources returns the source of the shards of p written next to
+// outputFile by file name.
+func (p *Plan) shardSources(outputFile, invocation string, stringEncoding bool) (map[string][]byte, error) {
+	sources := make(map[string][]byte)
+	index := p.entryIndex()
+	for i, files := range p.shards(stringEncoding) {
+		name := shardFileName(outputFile, i)
+		var buf bytes.Buffer
+		if err := shardTmpl.Execute(&buf, map[string]interface{}{
+			"Invocation":     invocation,
+			"PackageName":    p.conf.Package,
+			"BuildTags":      devConstraint(p.conf.BuildTags, p.conf.DevTag, false),
+			"Files":          files,
+			"EntryIndex":     index,
+			"StringEncoding": stringEncoding,
+		}); err != nil {
+			return nil, errors.Wrap(err, "shard template execution")
+		}
+		data, err := formatFile(p.conf, renameIdents(buf.Bytes(), p.conf.IdentPrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "format %s", name)
+		}
+		var out bytes.Buffer
+		if err := writeSource(&out, data, p.files); err != nil {
+			return nil, err
+		}
+		sources[name] = out.Bytes()
+	}
+	return sources, nil
+}
+
+const shardTemplate = `// Code generated by "esc{{with .Invocation}} {{.}}{{end}}"; DO NOT EDIT.
+{{- with .BuildTags}}
+
+//go:build {{.}}
+{{- end}}
+
+package {{.PackageName}}
+
+const (
+{{- range .Files}}
+	// {{.Name}}
+	{{- if not .Stored}}
+	_escCompressed{{index $.EntryIndex .Name}} = {{if $.StringEncoding}}{{literal .GzipData}}{{else}}` + "`" + `{{printf "\n\x01%d\n" (index $.EntryIndex .Name)}}` + "`" + `{{end}}
+	{{- end}}
+	{{- if or .Dual .Stored}}
+	_escRaw{{index $.EntryIndex .Name}} = {{literal .Data}}
+	{{- end}}
+	{{- if .Brotli}}
+	_escBrotli{{index $.EntryIndex .Name}} = {{literal .Brotli}}
+	{{- end}}
+{{- end}}
+)
+`
@@ -0,0 +1,96 @@
+package embed
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestShards(t *testing.T) {
+	root := t.TempDir()
+	rnd := rand.New(rand.NewSource(1))
+	files := make(map[string]string)
+	for i := 0; i < 10; i++ {
+		b := make([]byte, 1000)
+		rnd.Read(b)
+		files[fmt.Sprintf("assets/f%d.bin", i)] = string(b)
+	}
+	files["assets/big.bin"] = strings.Repeat("x", 5000) + files["assets/f0.bin"]
+	writeTree(t, root, files)
+	out := filepath.Join(root, "pkg")
+	writeTree(t, out, map[string]string{"static_009.go": "package main\n"})
+	conf := &Config{
+		OutputFile:      filepath.Join(out, "static.go"),
+		Package:         "main",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		SkipModuleCheck: true,
+		ShardSize:       6000,
+	}
+	var buf strings.Builder
+	if err := Run(conf, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), `\x`) {
+		t.Error("Run() with ShardSize embedded data in the output file")
+	}
+	shards, err := shardFiles(conf.OutputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The random files are stored quoted, which takes about 2900 bytes, so
+	// they are two to a shard, and big.bin, which compresses well, shares one
+	// with f0.bin.
+	if len(shards) != 6 {
+		t.Errorf("Run() wrote shards %v, want 6", shards)
+	}
+	sources := make(map[string]string)
+	for _, name := range shards {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources[filepath.Base(name)] = string(b)
+	}
+	var test strings.Builder
+	test.WriteString(`package main
+
+import "testing"
+
+func TestShards(t *testing.T) {
+	for name, want := range map[string]string{
+`)
+	for name, content := range files {
+		fmt.Fprintf(&test, "\t\t%q: %s,\n", strings.TrimPrefix(name, "assets"), strconv.Quote(content))
+	}
+	test.WriteString(`	} {
+		if s := FSMustString(false, name); s != want {
+			t.Errorf("FSMustString(%q) differs", name)
+		}
+	}
+}
+`)
+	sources["static_test.go"] = test.String()
+	runGenerated(t, conf, sources, "test", ".")
+
+	conf.ShardSize = 0
+	if err := Run(conf, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if shards, err := shardFiles(conf.OutputFile); err != nil || len(shards) != 0 {
+		t.Errorf("shards after Run() without ShardSize = %v, %v, want none", shards, err)
+	}
+	if _, err := os.Stat(filepath.Join(out, "static_009.go")); !os.IsNotExist(err) {
+		t.Error("Run() left a stale shard")
+	}
+
+	conf.ShardSize, conf.OutputFile = 1, ""
+	if _, err := Collect(conf); err == nil {
+		t.Error("Collect() with ShardSize but no output file must err")
+	}
+}
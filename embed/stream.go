@@ -0,0 +1,91 @@
+package embed
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+)
+
+// streamSize is the size from which local files are streamed: their
+// content is hashed while collecting and read again while writing the
+// output, compressed and base64 encoded straight into it, so neither it nor
+// its gzip data is held in memory.
+var streamSize int64 = 64 << 20
+
+// streams reports whether large files can be streamed with conf: with the
+// base64 encoding, compressed, and without options that need the content or
+// the gzip data of files before the output is written.
+func streams(conf *Config) bool {
+	return (conf.Encoding == "" || conf.Encoding == EncodingBase64) &&
+		!conf.MetadataOnly && !conf.NoCompression && !conf.ZeroCopy &&
+		!conf.UseGoEmbed && conf.WrapEmbedVar == "" && conf.ShardSize == 0 &&
+		len(conf.EncryptionKey) == 0 && len(conf.DualStorage) == 0 &&
+		len(conf.Minifiers) == 0 && !conf.PrecompressedBrotli
+}
+
+// setStreamed records the local file fname, which r reads, as the content
+// of f without holding it in memory: only its size, hash and first bytes,
+// for the content type, are kept.
+func (f *_escFile) setStreamed(fname string, r io.Reader, fingerprint bool) error {
+	h := sha256.New()
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	h.Write(head[:n])
+	size, err := io.Copy(h, r)
+	if err != nil {
+		return err
+	}
+	f.streamPath, f.head = fname, head[:n]
+	f.Size = int64(n) + size
+	f.SHA256 = hex.EncodeToString(h.Sum(nil))
+	f.Version = f.SHA256[:versionLen]
+	if fingerprint {
+		f.Fingerprint = fingerprintName(f.Name, f.Version)
+	}
+	return nil
+}
+
+// writeStreamed writes the compressed data of the streamed file f to w,
+// reading it again, and fails if it changed since it was collected.
+func (f *_escFile) writeStreamed(w io.Writer) error {
+	r, err := os.Open(f.streamPath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	cw := &countingWriter{w: w}
+	zw, err := newCompressor(cw, f.compression, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(zw, h), r); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if hex.EncodeToString(h.Sum(nil)) != f.SHA256 {
+		return fmt.Errorf("%s: changed while generating the output", f.Local)
+	}
+	f.CompressedSize = cw.n
+	return nil
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
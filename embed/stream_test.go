@@ -0,0 +1,75 @@
+package embed
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	defer func(n int64) { streamSize = n }(streamSize)
+	streamSize = 1000
+	root := t.TempDir()
+	large := strings.Repeat("streamed content\n", 1000)
+	writeTree(t, root, map[string]string{"data/large.txt": large, "data/small.txt": "small"})
+	conf := &Config{
+		Package: "main",
+		Files:   []string{filepath.Join(root, "data")},
+		Prefix:  filepath.Join(root, "data"),
+	}
+	p, err := Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range p.files {
+		streamed := f.Data == nil && f.GzipData == nil
+		if streamed != (f.Name == "/large.txt") {
+			t.Errorf("%s streamed = %t", f.Name, streamed)
+		}
+		if f.Name == "/large.txt" && (f.Size != int64(len(large)) || f.SHA256 != contentHash([]byte(large)) || f.ContentType != "text/plain; charset=utf-8") {
+			t.Errorf("%s: size %d, sha256 %s, content type %q", f.Name, f.Size, f.SHA256, f.ContentType)
+		}
+	}
+	if quick, err := QuickFingerprint(conf); err != nil || quick != p.Fingerprint() {
+		t.Errorf("QuickFingerprint() = %s, %v, want %s", quick, err, p.Fingerprint())
+	}
+
+	runGenerated(t, conf, map[string]string{"main.go": `package main
+
+import "strings"
+
+func main() {
+	if FSMustString(false, "/large.txt") != strings.Repeat("streamed content\n", 1000) {
+		panic("large.txt differs")
+	}
+	if FSMustString(false, "/small.txt") != "small" {
+		panic("small.txt differs")
+	}
+}
+`}, "run", ".")
+
+	res, err := RunWithResult(conf, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range res.Files {
+		if f.Name == "/large.txt" && (f.EmbeddedSize == 0 || f.EmbeddedSize >= f.Size) {
+			t.Errorf("%s: embedded size %d of %d", f.Name, f.EmbeddedSize, f.Size)
+		}
+	}
+
+	// The output is not written with content that changed since collecting.
+	p, err = Collect(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTree(t, root, map[string]string{"data/large.txt": strings.ToUpper(large)})
+	var c cleanups
+	err = p.render(&bytes.Buffer{}, &c)
+	if err == nil || !strings.Contains(err.Error(), "changed while generating") {
+		t.Errorf("render() after a change = %v", err)
+	}
+	c.runUnless(&err)
+}
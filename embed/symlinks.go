@@ -0,0 +1,49 @@
+package embed
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Symlink policies for Config.Symlinks.
+const (
+	// SymlinksFollow embeds the targets of symlinks as if they were in place
+	// of the symlinks. Symlinks back to a directory being walked are an
+	// error.
+	SymlinksFollow = "follow"
+	// SymlinksSkip leaves out symlinks found in embedded directories.
+	SymlinksSkip = "skip"
+	// SymlinksError makes symlinks found in embedded directories an error.
+	SymlinksError = "error"
+)
+
+// checkSymlinks returns an error if policy is not a symlink policy.
+func checkSymlinks(policy string) error {
+	switch policy {
+	case "", SymlinksFollow, SymlinksSkip, SymlinksError:
+		return nil
+	}
+	return fmt.Errorf("unknown symlink policy %q, want %s, %s or %s", policy, SymlinksFollow, SymlinksSkip, SymlinksError)
+}
+
+// dirChains detects symlink cycles by recording, for every directory walked,
+// the real paths of it and the directories it was reached through.
+type dirChains map[string][]string
+
+// enter records the directory fname, reached through its parent directory,
+// and returns an error if it is one of the directories it was reached
+// through.
+func (c dirChains) enter(fname string) error {
+	real, err := filepath.EvalSymlinks(fname)
+	if err != nil {
+		return err
+	}
+	parents := c[filepath.Dir(fname)]
+	for _, p := range parents {
+		if p == real {
+			return fmt.Errorf("%s: symlink cycle back to %s", fname, real)
+		}
+	}
+	c[filepath.Clean(fname)] = append(parents[:len(parents):len(parents)], real)
+	return nil
+}
@@ -0,0 +1,62 @@
+package embed
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSymlinks(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"assets/a.txt": "a",
+		"shared/b.txt": "b",
+	})
+	for link, target := range map[string]string{
+		"assets/shared": filepath.Join("..", "shared"),
+		"assets/c.txt":  "a.txt",
+	} {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Skip(err)
+		}
+	}
+	assets := filepath.Join(root, "assets")
+	names := func(policy string) ([]string, error) {
+		p, err := Collect(&Config{Files: []string{assets}, Prefix: assets, Symlinks: policy, SkipModuleCheck: true})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, f := range p.files {
+			names = append(names, f.Name)
+		}
+		return names, nil
+	}
+	for policy, want := range map[string][]string{
+		"":             {"/a.txt", "/c.txt", "/shared/b.txt"},
+		SymlinksFollow: {"/a.txt", "/c.txt", "/shared/b.txt"},
+		SymlinksSkip:   {"/a.txt"},
+	} {
+		if got, err := names(policy); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Collect() with Symlinks %q = %q, %v, want %q", policy, got, err, want)
+		}
+	}
+	if _, err := names(SymlinksError); err == nil || !strings.Contains(err.Error(), "symlinks are not allowed") {
+		t.Errorf("Collect() with Symlinks %q = %v, want a symlink error", SymlinksError, err)
+	}
+	if _, err := names("ignore"); err == nil {
+		t.Error("Collect() with an unknown symlink policy must err")
+	}
+
+	if err := os.Symlink(filepath.Join("..", "assets"), filepath.Join(root, "shared", "back")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := names(""); err == nil || !strings.Contains(err.Error(), "symlink cycle") {
+		t.Errorf("Collect() with a symlink cycle = %v, want a cycle error", err)
+	}
+	if got, err := names(SymlinksSkip); err != nil || !reflect.DeepEqual(got, []string{"/a.txt"}) {
+		t.Errorf("Collect() with a skipped symlink cycle = %q, %v", got, err)
+	}
+}
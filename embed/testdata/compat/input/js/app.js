@@ -0,0 +1,12 @@
+document.querySelectorAll(".item-0").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-1").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-2").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-3").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-4").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-5").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-6").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-7").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-8").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-9").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-10").forEach(function (e) { e.hidden = false; });
+document.querySelectorAll(".item-11").forEach(function (e) { e.hidden = false; });
@@ -0,0 +1,37 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseVirtualFile parses spec, name=value, into the canonical name and
+// contents of a file for Config.InlineFiles. The contents are the standard
+// output of the command after a leading @ in value, split into fields and
+// run without a shell, or else value itself, with a leading @@ standing for
+// @, e.g. "/buildinfo.txt=@git describe" or "/handle.txt=@@esc".
+func ParseVirtualFile(spec string) (name string, content []byte, err error) {
+	name, value, ok := strings.Cut(spec, "=")
+	if !ok {
+		return "", nil, errors.New("want name=value")
+	}
+	if strings.HasPrefix(value, "@@") || !strings.HasPrefix(value, "@") {
+		return name, []byte(strings.TrimPrefix(value, "@")), nil
+	}
+	args := strings.Fields(value[1:])
+	if len(args) == 0 {
+		return "", nil, errors.New("want a command after @")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	b, err := cmd.Output()
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %v\n%s", value[1:], err, stderr.Bytes())
+	}
+	return name, b, nil
+}
@@ -0,0 +1,40 @@
+package embed
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestParseVirtualFile(t *testing.T) {
+	for spec, want := range map[string]struct{ name, content string }{
+		"/stamp.txt=2024-01-01": {"/stamp.txt", "2024-01-01"},
+		"/empty.txt=":           {"/empty.txt", ""},
+		"/eq.txt=a=b":           {"/eq.txt", "a=b"},
+		"/at.txt=@@handle":      {"/at.txt", "@handle"},
+		"/atat.txt=@@@x":        {"/atat.txt", "@@x"},
+	} {
+		name, content, err := ParseVirtualFile(spec)
+		if err != nil || name != want.name || string(content) != want.content {
+			t.Errorf("ParseVirtualFile(%q) = %q, %q, %v, want %q, %q", spec, name, content, err, want.name, want.content)
+		}
+	}
+
+	if _, err := exec.LookPath("echo"); err == nil {
+		name, content, err := ParseVirtualFile("/version.txt=@echo  v1.2.3   beta")
+		if err != nil || name != "/version.txt" || string(content) != "v1.2.3 beta\n" {
+			t.Errorf("ParseVirtualFile() of a command = %q, %q, %v", name, content, err)
+		}
+	}
+
+	for spec, want := range map[string]string{
+		"/stamp.txt":                 "want name=value",
+		"/cmd.txt=@":                 "want a command after @",
+		"/cmd.txt=@   ":              "want a command after @",
+		"/cmd.txt=@esc-no-such-tool": "esc-no-such-tool:",
+	} {
+		if _, _, err := ParseVirtualFile(spec); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseVirtualFile(%q) error = %v, want %q", spec, err, want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package embed
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+)
+
+// DefaultWatchDebounce is the default of WatchOptions.Debounce.
+const DefaultWatchDebounce = time.Second
+
+// WatchOptions configure Watch.
+type WatchOptions struct {
+	// Debounce is how long the inputs must be unchanged before the output is
+	// regenerated, so a burst of changes regenerates it once. It defaults to
+	// DefaultWatchDebounce.
+	Debounce time.Duration
+	// OnRun, if set, is called after every run with its result or error.
+	OnRun func(res *RunResult, err error)
+}
+
+// Watch runs conf, then watches the directories under its local inputs with
+// file system notifications and runs it again once they are unchanged for
+// the debounce time, until ctx is done, when it returns ctx.Err(). Failing
+// runs do not stop it and leave the output file as it was. Directories
+// created under the inputs are watched as well. Remote files are not
+// watched, as their content is pinned.
+//
+// After a burst of changes, conf only runs again if its QuickFingerprint
+// changed, so writing the output file next to the inputs does not
+// regenerate it. Remote files and the output of minifier commands are
+// cached by their inputs for that.
+func Watch(ctx context.Context, conf *Config, opts WatchOptions) error {
+	if conf.OutputFile == "" {
+		return errors.New("watching requires an output file")
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = DefaultWatchDebounce
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "watch")
+	}
+	defer w.Close()
+	watchInputs(w, conf)
+	// generated is the fingerprint of the inputs as of the last run. settled
+	// fires once the inputs are unchanged for the debounce time after an
+	// event; every event replaces it.
+	generated := watchRun(conf, opts)
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-w.Events:
+			if ev.Op&fsnotify.Create != 0 {
+				watchDir(w, ev.Name)
+			}
+			settled = time.After(opts.Debounce)
+		case <-w.Errors:
+			// Events may have been lost, e.g. if the queue overflowed, so
+			// the inputs are checked as after a change.
+			settled = time.After(opts.Debounce)
+		case <-settled:
+			settled = nil
+			watchInputs(w, conf)
+			if watchFingerprint(conf) != generated {
+				generated = watchRun(conf, opts)
+			}
+		}
+	}
+}
+
+// watchRun runs conf, replacing the output file if it succeeds, and returns
+// the fingerprint of the inputs afterwards, which covers the output if it
+// is among them.
+func watchRun(conf *Config, opts WatchOptions) string {
+	res, err := runToFile(conf)
+	if opts.OnRun != nil {
+		opts.OnRun(res, err)
+	}
+	return watchFingerprint(conf)
+}
+
+// watchFingerprint returns the QuickFingerprint of conf or, if it fails,
+// e.g. while a file is being replaced, the error, which a later check
+// finds changed once the inputs are readable again.
+func watchFingerprint(conf *Config) string {
+	fp, err := QuickFingerprint(conf)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	return fp
+}
+
+// watchInputs adds the directories under the local inputs of conf to w,
+// and the directories of the input files, which also see them replaced.
+// Inputs that do not exist are skipped, and adding a directory twice has
+// no effect.
+func watchInputs(w *fsnotify.Watcher, conf *Config) {
+	roots := append([]string(nil), conf.Files...)
+	for _, g := range conf.Groups {
+		roots = append(roots, g.Files...)
+	}
+	for _, m := range conf.Mounts {
+		roots = append(roots, m.Src)
+	}
+	roots = append(roots, conf.IgnoreFile, conf.IncludeFile)
+	for _, root := range roots {
+		if root == "" || isRemote(root) {
+			continue
+		}
+		if fi, err := os.Stat(root); err == nil && !fi.IsDir() {
+			root = filepath.Dir(root)
+		}
+		watchDir(w, root)
+	}
+}
+
+// watchDir adds dir and the directories under it to w, following symlinks
+// to directories, if dir is a directory.
+func watchDir(w *fsnotify.Watcher, dir string) {
+	seen := make(map[string]bool)
+	var walk func(dir string)
+	walk = func(dir string) {
+		filepath.Walk(dir, func(name string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return nil
+			}
+			if fi.Mode()&os.ModeSymlink != 0 {
+				if target, err := filepath.EvalSymlinks(name); err == nil && !seen[target] {
+					if fi, err := os.Stat(target); err == nil && fi.IsDir() {
+						walk(name + string(filepath.Separator))
+					}
+				}
+				return nil
+			}
+			if !fi.IsDir() {
+				return nil
+			}
+			if real, err := filepath.EvalSymlinks(name); err == nil {
+				if seen[real] {
+					return filepath.SkipDir
+				}
+				seen[real] = true
+			}
+			w.Add(name)
+			return nil
+		})
+	}
+	walk(dir)
+}
+
+// runToFile runs conf and writes the output to conf.OutputFile, which is
+// only replaced if the run succeeds.
+func runToFile(conf *Config) (res *RunResult, err error) {
+	name, err := outputPath(conf)
+	if err != nil {
+		return nil, err
+	}
+	var c cleanups
+	defer c.runUnless(&err)
+	err = c.create(name, func(w io.Writer) error {
+		res, err = RunWithResult(conf, w)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package embed
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"assets/a.txt": "a"})
+	conf := &Config{
+		OutputFile:      filepath.Join(root, "static.go"),
+		Package:         "main",
+		Prefix:          filepath.Join(root, "assets"),
+		Files:           []string{filepath.Join(root, "assets")},
+		NoCompression:   true,
+		SkipModuleCheck: true,
+	}
+	runs := make(chan error, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- Watch(ctx, conf, WatchOptions{
+			Debounce: 300 * time.Millisecond,
+			OnRun:    func(_ *RunResult, err error) { runs <- err },
+		})
+	}()
+	wait := func() {
+		t.Helper()
+		select {
+		case err := <-runs:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("no run")
+		}
+	}
+	output := func() string {
+		t.Helper()
+		b, err := ioutil.ReadFile(conf.OutputFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+	wait()
+	if !strings.Contains(output(), `"/a.txt"`) {
+		t.Fatalf("initial output lacks /a.txt:\n%s", output())
+	}
+
+	// A burst of changes is debounced into one run.
+	for _, name := range []string{"assets/b.txt", "assets/c.txt", "assets/d.txt"} {
+		writeTree(t, root, map[string]string{name: "x"})
+		time.Sleep(20 * time.Millisecond)
+	}
+	wait()
+	if out := output(); !strings.Contains(out, `"/b.txt"`) || !strings.Contains(out, `"/d.txt"`) {
+		t.Errorf("output after changes lacks /b.txt or /d.txt:\n%s", out)
+	}
+	select {
+	case <-runs:
+		t.Error("changes were not debounced into one run")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// New directories are watched, and files written to them found.
+	writeTree(t, root, map[string]string{"assets/css/e.css": "x"})
+	wait()
+	time.Sleep(50 * time.Millisecond)
+	writeTree(t, root, map[string]string{"assets/css/f.css": "x"})
+	wait()
+	if out := output(); !strings.Contains(out, `"/css/f.css"`) {
+		t.Errorf("output after changes in a new directory lacks /css/f.css:\n%s", out)
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Watch() = %v, want context.Canceled", err)
+	}
+	if err := Watch(ctx, &Config{}, WatchOptions{}); err == nil {
+		t.Error("Watch() without an output file must err")
+	}
+}
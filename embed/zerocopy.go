@@ -0,0 +1,19 @@
+package embed
+
+import "github.com/pkg/errors"
+
+// checkZeroCopy validates the Config of zero-copy output.
+func checkZeroCopy(conf *Config) error {
+	if !conf.ZeroCopy {
+		return nil
+	}
+	switch {
+	case conf.MetadataOnly || conf.WrapEmbedVar != "" || conf.UseGoEmbed:
+		return errors.New("zero copy requires file contents embedded as strings")
+	case len(conf.DualStorage) > 0:
+		return errors.New("zero copy embeds every file uncompressed, there is no dual storage")
+	case conf.PrecompressedBrotli:
+		return errors.New("zero copy serves no precompressed variants")
+	}
+	return nil
+}
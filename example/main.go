@@ -1,6 +1,6 @@
 package main
 
-//go:generate go run ../main.go -prefix ../testdata -o static.go ../testdata
+//go:generate go run ../main.go -prefix ../testdata -conformance -o static.go ../testdata
 import (
 	"fmt"
 	"log"
@@ -0,0 +1,63 @@
+// Code generated by "esc -prefix ../testdata -conformance -o static.go ../testdata"; DO NOT EDIT.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/sbstnsp/esc/embed/esctest"
+)
+
+// _escManifest describes every embedded file and directory.
+var _escManifest = []esctest.Entry{
+	{Name: "/", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/assets", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/assets/css", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/assets/js", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/assets/txt", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/empty", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/images", IsDir: true, Size: 0, ModTime: 0, SHA256: ""},
+	{Name: "/LICENSE.txt", IsDir: false, Size: 17128, ModTime: 1649320745, SHA256: "d7b98629668e4968281c7083336bc292ae55e2ca3a5469072b9657dd2c1a634e"},
+	{Name: "/README.txt", IsDir: false, Size: 930, ModTime: 1649320745, SHA256: "56b0dcd9c06fc36dc85007a4ddcf7fa8b9237240ad1bbf64711976d57a725656"},
+	{Name: "/assets/css/main.css", IsDir: false, Size: 83920, ModTime: 1649320745, SHA256: "966ddee7941e80feed131a547cf63a8152d66a38138e1b4af0471c7f94b2b448"},
+	{Name: "/assets/css/noscript.css", IsDir: false, Size: 891, ModTime: 1649320745, SHA256: "af6cf0dab62ac97d4d4c7e05ba662f4a4e45d619642300228899ae49e783f098"},
+	{Name: "/assets/js/breakpoints.min.js", IsDir: false, Size: 2439, ModTime: 1649320745, SHA256: "309febcd6d6e0cf092201532215f03a6a9f30b30f26203272a4861d704e7cd52"},
+	{Name: "/assets/js/browser.min.js", IsDir: false, Size: 1851, ModTime: 1649320745, SHA256: "87910d5ed0053d90caf83230a2f1811d8679815da01f7bdec7548e776d7f04c4"},
+	{Name: "/assets/js/jquery.min.js", IsDir: false, Size: 86927, ModTime: 1649320745, SHA256: "160a426ff2894252cd7cebbdd6d6b7da8fcd319c65b70468f10b6690c45d02ef"},
+	{Name: "/assets/js/jquery.scrollex.min.js", IsDir: false, Size: 2257, ModTime: 1649320745, SHA256: "fc25b75fb3fc8b42756413be387e0d7a602813125283d2384551961d73ea784e"},
+	{Name: "/assets/js/jquery.scrolly.min.js", IsDir: false, Size: 831, ModTime: 1649320745, SHA256: "8b6571ea2c3631ff50bb4b96e7f9081c6e33ebaadef9cb2ca5955d5e0b625a02"},
+	{Name: "/assets/js/main.js", IsDir: false, Size: 5346, ModTime: 1649320745, SHA256: "f20785465a7789711083b554ccb1ef2b364ddd858945511ae11f8eb18b21fc3a"},
+	{Name: "/assets/js/util.js", IsDir: false, Size: 12433, ModTime: 1649320745, SHA256: "c2e1e72b0de356f6ce184e3af4fa8ab6590a2581162905a27d77886b2d960e00"},
+	{Name: "/assets/txt/1.txt", IsDir: false, Size: 9, ModTime: 1649320745, SHA256: "e77174030fd5da23beea67178885a9fd8c29782fe4ff8a24e66e483c28ae2d10"},
+	{Name: "/elements.html", IsDir: false, Size: 21926, ModTime: 1649320745, SHA256: "303cc8d60d583feb22ce70f458f00d32195bdb6a7501af9fdc42c54863a14beb"},
+	{Name: "/empty.expect", IsDir: false, Size: 33686, ModTime: 1792070757, SHA256: "94ac3cd45cb2f5f93748918f7889c1728c8a8e8ec233f35b9d19c8d134e54684"},
+	{Name: "/empty/1", IsDir: false, Size: 0, ModTime: 1649320745, SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	{Name: "/empty/2", IsDir: false, Size: 0, ModTime: 1649320745, SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	{Name: "/generic.html", IsDir: false, Size: 5858, ModTime: 1649320745, SHA256: "ec0505695abe69f0a11144742e42b4c2cb28cc2c7d569e5ba16ad0aa09c81890"},
+	{Name: "/images/bg.jpg", IsDir: false, Size: 405114, ModTime: 1649320745, SHA256: "7a1a206fa5d5e5eb6d0e8a586c6ca8034af78139d7a9efbda45815b3e334265f"},
+	{Name: "/images/overlay.png", IsDir: false, Size: 2807, ModTime: 1649320745, SHA256: "e7e5bbf97ef6edb13b603fb88bd2d33ae8db022a0eb72e78c235a39791284784"},
+	{Name: "/images/pic01.jpg", IsDir: false, Size: 60917, ModTime: 1649320745, SHA256: "3cfb5781bda89d37955b130cb0cec4f5f8e26488227b0afe1e29a3ae6849bb54"},
+	{Name: "/images/pic02.jpg", IsDir: false, Size: 20638, ModTime: 1649320745, SHA256: "16e8b3059f323e034d2ec2f627f5275cb4ae75841bb3b37acb41c63209996b00"},
+	{Name: "/images/pic03.jpg", IsDir: false, Size: 20643, ModTime: 1649320745, SHA256: "202ea8b35ff971a73659184eff87b91523746cb4ea5d8a734e2469c5cd4ba809"},
+	{Name: "/images/pic04.jpg", IsDir: false, Size: 20737, ModTime: 1649320745, SHA256: "00706edb8a87994406d928eacff856969e560aea902fa8b222b3be281c981047"},
+	{Name: "/images/pic05.jpg", IsDir: false, Size: 21198, ModTime: 1649320745, SHA256: "9af30f00bdb8f48cc49bd3a8a6bbe1338f82aa921c43c296504266b28b6860a4"},
+	{Name: "/images/pic06.jpg", IsDir: false, Size: 21124, ModTime: 1649320745, SHA256: "d489b94984f8375058c4a989d104e2dc2850402f07ae2c3705d66be07d736484"},
+	{Name: "/images/pic07.jpg", IsDir: false, Size: 21220, ModTime: 1649320745, SHA256: "3a92fd0b55ae74520e586b71110db83a57dc978af4c854d59ec8d6a3a3f501f2"},
+	{Name: "/images/pic08.jpg", IsDir: false, Size: 13411, ModTime: 1649320745, SHA256: "cae61484ce9e27c9759e1e89b16ba3f1d0b7adc187038d382df2bba24fa99572"},
+	{Name: "/images/pic09.jpg", IsDir: false, Size: 13035, ModTime: 1649320745, SHA256: "5c2a02cd1cf64b313b88a469dbfef6b884009ecc7fe8c67e8a9bf10b5f0b9cc2"},
+	{Name: "/index.html", IsDir: false, Size: 9054, ModTime: 1649320745, SHA256: "11e9393f7fad3e2184274db7ce0c299e2ed8c96f5da9b166271643fc55ad5051"},
+}
+
+func Test_escConformanceStatic(t *testing.T) {
+	esctest.TestFileSystem(t, FS(false), _escManifest)
+}
+
+func Test_escConformanceLocal(t *testing.T) {
+	// Modification times on disk depend on the checkout.
+	manifest := make([]esctest.Entry, len(_escManifest))
+	for i, e := range _escManifest {
+		e.ModTime = 0
+		manifest[i] = e
+	}
+	esctest.TestFileSystem(t, FS(true), manifest)
+}
@@ -2,14 +2,22 @@ package main
 
 import (
 	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 )
 
 func Test_FileServer(t *testing.T) {
@@ -192,6 +200,286 @@ func testFSReaddir(useLocal bool, t *testing.T) {
 	}
 }
 
+func TestFSRestricted_escStatic(t *testing.T) {
+	testFSRestricted(false, t)
+}
+
+func TestFSRestricted_escLocal(t *testing.T) {
+	testFSRestricted(true, t)
+}
+
+func testFSRestricted(useLocal bool, t *testing.T) {
+	fs, err := FSRestricted(useLocal, "/index.html", "assets/css/*.css")
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"/index.html", false},
+		{"/assets/css/main.css", false},
+		{"assets/css/noscript.css", false},
+		{"/generic.html", true},
+		{"/assets/js/main.js", true},
+		{"/assets/css/../../generic.html", true},
+		{"../generic.html", true},
+		{"/assets/css/../txt/1.txt", true},
+	}
+	for _, tt := range open {
+		f, err := fs.Open(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q. FSRestricted(%t).Open() error = %v, wantErr %v", tt.name, useLocal, err, tt.wantErr)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			t.Errorf("%q. FSRestricted(%t).Open() error = %v, want not exist", tt.name, useLocal, err)
+		}
+		if err == nil {
+			f.Close()
+		}
+	}
+
+	listings := []struct {
+		name  string
+		count int
+		want  string
+	}{
+		{"/", -1, "assets index.html"},
+		{"/assets", -1, "css"},
+		{"/assets", 1, "css"},
+		{"/assets/css", 1, "main.css noscript.css"},
+	}
+	for _, tt := range listings {
+		f, err := fs.Open(tt.name)
+		if err != nil {
+			t.Errorf("%q. FSRestricted(%t).Open() error = %v", tt.name, useLocal, err)
+			continue
+		}
+		var names []string
+		for {
+			fis, err := f.Readdir(tt.count)
+			for _, fi := range fis {
+				names = append(names, fi.Name())
+			}
+			if err != nil || tt.count <= 0 {
+				break
+			}
+		}
+		f.Close()
+		if got := strings.Join(names, " "); got != tt.want {
+			t.Errorf("%q. FSRestricted(%t) Readdir(%d) = %s, want %s", tt.name, useLocal, tt.count, got, tt.want)
+		}
+	}
+
+	for _, allowed := range []string{"/missing.html", "/assets/*.png", "/assets/["} {
+		if _, err := FSRestricted(useLocal, allowed); err == nil {
+			t.Errorf("FSRestricted(%t, %q) succeeded, want error", useLocal, allowed)
+		}
+	}
+}
+
+func TestIOFS_escStatic(t *testing.T) {
+	testIOFS(false, t)
+}
+
+func TestIOFS_escLocal(t *testing.T) {
+	testIOFS(true, t)
+}
+
+func testIOFS(useLocal bool, t *testing.T) {
+	fsys := IOFS(useLocal)
+	if err := fstest.TestFS(fsys, "index.html", "assets/css/main.css", "assets/txt/1.txt", "empty/1"); err != nil {
+		t.Error(err)
+	}
+	if b, err := fs.ReadFile(fsys, "assets/txt/1.txt"); err != nil || string(b) != FSMustString(useLocal, "/assets/txt/1.txt") {
+		t.Errorf("fs.ReadFile() = %q, %v", b, err)
+	}
+	for _, name := range []string{"/index.html", "assets/../index.html", "ololo"} {
+		if _, err := fsys.Open(name); err == nil {
+			t.Errorf("Open(%q) must err", name)
+		}
+	}
+}
+
+func TestFSHash(t *testing.T) {
+	raw, err := ioutil.ReadFile("../testdata/assets/txt/1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(raw)
+	want := hex.EncodeToString(sum[:])
+	if got, err := FSHash("/assets/txt/1.txt"); err != nil || got != want {
+		t.Errorf("FSHash() = %q, %v, want %q", got, err, want)
+	}
+	if _, err := FSHash("/assets"); err == nil {
+		t.Error("FSHash() of a directory must err")
+	}
+
+	h := FSHandler(false, FSHandlerOptions{})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/assets/txt/1.txt", nil))
+	if etag := w.Header().Get("ETag"); etag != `"`+want+`"` {
+		t.Errorf("ETag = %q, want the quoted hash", etag)
+	}
+	r := httptest.NewRequest("GET", "/assets/txt/1.txt", nil)
+	r.Header.Set("If-None-Match", `"`+want+`"`)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("status with a matching If-None-Match = %d, want %d", w.Code, http.StatusNotModified)
+	}
+}
+
+func TestFSGzipHandler(t *testing.T) {
+	h := FSGzipHandler(FSHandlerOptions{})
+	get := func(name, acceptEncoding string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", name, nil)
+		if acceptEncoding != "" {
+			r.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	want := FSMustString(false, "/assets/css/main.css")
+	for _, acceptEncoding := range []string{
+		"gzip", "br, gzip;q=0.8", "*", "GZip", "gzip; q=0.001", "*;q=0, gzip",
+		"gzip, *;q=0", "gzip;q=1.0, *;q=0.5", "*;q=0.5, identity", "deflate, gzip;level=9;q=0.5",
+		"gzip;q=0, gzip;q=1", "identity,gzip",
+	} {
+		w := get("/assets/css/main.css", acceptEncoding)
+		if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding %q: Content-Encoding = %q, want gzip", acceptEncoding, got)
+			continue
+		}
+		if ctype := w.Header().Get("Content-Type"); !strings.HasPrefix(ctype, "text/css") {
+			t.Errorf("Content-Type = %q, want text/css", ctype)
+		}
+		gr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, err := ioutil.ReadAll(gr); err != nil || string(b) != want {
+			t.Errorf("gunzipped body = %d bytes, %v, want main.css", len(b), err)
+		}
+	}
+	for _, acceptEncoding := range []string{
+		"", "br", "gzip;q=0", "gzip;q=0.000", "gzip;Q=0", "*;q=0", "gzip;q=0, *",
+		"*, gzip;q=0", "x-gzip", "identity", "gzipped",
+	} {
+		w := get("/assets/css/main.css", acceptEncoding)
+		if got := w.Header().Get("Content-Encoding"); got != "" || w.Body.String() != want {
+			t.Errorf("Accept-Encoding %q: Content-Encoding = %q, want main.css uncompressed", acceptEncoding, got)
+		}
+		if vary := w.Header().Get("Vary"); vary != "Accept-Encoding" {
+			t.Errorf("Vary = %q, want Accept-Encoding", vary)
+		}
+	}
+	// Repeated headers are combined.
+	r := httptest.NewRequest("GET", "/assets/css/main.css", nil)
+	r.Header.Add("Accept-Encoding", "identity")
+	r.Header.Add("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Errorf("Accept-Encoding identity and gzip: Content-Encoding = %q, want gzip", got)
+	}
+	// 1.txt is too small to be embedded compressed.
+	if w := get("/assets/txt/1.txt", "gzip"); w.Header().Get("Content-Encoding") != "" || w.Body.String() != "some-text" {
+		t.Errorf("uncompressed file served with Content-Encoding %q: %q", w.Header().Get("Content-Encoding"), w.Body.String())
+	}
+}
+
+func TestFSContentType(t *testing.T) {
+	for name, want := range map[string]string{
+		"/assets/css/main.css": "text/css; charset=utf-8",
+		"/images/bg.jpg":       "image/jpeg",
+		// Sniffed from the empty content.
+		"/empty/1": "text/plain; charset=utf-8",
+	} {
+		if got, err := FSContentType(name); err != nil || got != want {
+			t.Errorf("FSContentType(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+	for _, name := range []string{"/assets", "/missing.txt"} {
+		if _, err := FSContentType(name); err == nil {
+			t.Errorf("FSContentType(%q) must err", name)
+		}
+	}
+}
+
+func TestFSServeFile(t *testing.T) {
+	want := FSMustString(false, "/assets/css/main.css")
+	w := httptest.NewRecorder()
+	FSServeFile(w, httptest.NewRequest("GET", "/style", nil), "/assets/css/main.css")
+	h := w.Header()
+	if w.Code != http.StatusOK || w.Body.String() != want {
+		t.Errorf("FSServeFile() = %d with %d bytes, want main.css", w.Code, w.Body.Len())
+	}
+	if ctype := h.Get("Content-Type"); !strings.HasPrefix(ctype, "text/css") {
+		t.Errorf("Content-Type = %q, want text/css", ctype)
+	}
+	if h.Get("Content-Length") != fmt.Sprint(len(want)) || h.Get("Last-Modified") == "" || h.Get("ETag") == "" {
+		t.Errorf("headers = %v", h)
+	}
+
+	r := httptest.NewRequest("GET", "/style", nil)
+	r.Header.Set("Range", "bytes=0-9")
+	w = httptest.NewRecorder()
+	FSServeFile(w, r, "/assets/css/main.css")
+	if w.Code != http.StatusPartialContent || w.Body.String() != want[:10] {
+		t.Errorf("FSServeFile() of a range = %d %q, want %d %q", w.Code, w.Body.String(), http.StatusPartialContent, want[:10])
+	}
+
+	for _, name := range []string{"/missing.ico", "/assets"} {
+		w = httptest.NewRecorder()
+		FSServeFile(w, httptest.NewRequest("GET", "/", nil), name)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("FSServeFile(%q) = %d, want %d", name, w.Code, http.StatusNotFound)
+		}
+	}
+}
+
+func TestFSWalk(t *testing.T) {
+	var got []string
+	err := FSWalk("/assets/", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == "/assets/js" {
+			return fs.SkipDir
+		}
+		got = append(got, name)
+		return nil
+	})
+	want := []string{"/assets", "/assets/css", "/assets/css/main.css", "/assets/css/noscript.css", "/assets/txt", "/assets/txt/1.txt"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("FSWalk() = %q, %v, want %q", got, err, want)
+	}
+	if err := FSWalk("/missing", func(name string, d fs.DirEntry, err error) error { return err }); err == nil {
+		t.Error("FSWalk() of a missing root must err")
+	}
+}
+
+func TestFSGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"/assets/css/*.css", []string{"/assets/css/main.css", "/assets/css/noscript.css"}},
+		{"assets/**/main.*", []string{"/assets/css/main.css", "/assets/js/main.js"}},
+		{"/**/1*", []string{"/assets/txt/1.txt", "/empty/1"}},
+		{"/assets/*", []string{"/assets/css", "/assets/js", "/assets/txt"}},
+		{"/*.md", nil},
+		{"/assets/[", nil},
+	}
+	for _, tt := range tests {
+		if got := FSGlob(tt.pattern); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FSGlob(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
 func TestFSMustString_escStatic(t *testing.T) {
 	testFSMustString(false, t)
 }
@@ -226,3 +514,168 @@ func testFSMustString(useLocal bool, t *testing.T) {
 		})
 	}
 }
+
+func TestFSVersion(t *testing.T) {
+	// sha256("some-text")
+	const want = "e7717403"
+	if got, err := FSVersion("/assets/txt/1.txt"); err != nil || got != want {
+		t.Errorf("FSVersion() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := FSVersion("/assets/txt"); err == nil {
+		t.Errorf("FSVersion() of a directory must err")
+	}
+	if got := FSVersionedPath("/assets/txt/1.txt"); got != "/assets/txt/1.txt?v="+want {
+		t.Errorf("FSVersionedPath() = %v", got)
+	}
+	if got := FSVersionedPath("/ololo"); got != "/ololo" {
+		t.Errorf("FSVersionedPath() of a missing file = %v, want it unchanged", got)
+	}
+}
+
+func TestFSRelPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{"same dir", "/assets/css/main.css", "/assets/css/noscript.css", "noscript.css"},
+		{"itself", "/assets/css/main.css", "/assets/css/main.css", "main.css"},
+		{"sibling dir", "/assets/css/main.css", "/assets/js/util.js", "../js/util.js"},
+		{"from root file", "/index.html", "/assets/js/util.js", "assets/js/util.js"},
+		{"from root", "/", "/images/bg.jpg", "images/bg.jpg"},
+		{"to root", "/assets/css/main.css", "/", "../../"},
+		{"root to root", "/index.html", "/", "./"},
+		{"descendant", "/assets/", "/assets/txt/1.txt", "txt/1.txt"},
+		{"directory without slash", "/assets", "/assets/txt/1.txt", "assets/txt/1.txt"},
+		{"ancestor with slash", "/assets/txt/1.txt", "/assets/", "../"},
+		{"ancestor without slash", "/assets/txt/1.txt", "/assets", "../../assets"},
+		{"own directory", "/assets/txt/", "/assets/txt/", "./"},
+		{"directory target", "/index.html", "/assets/css/", "assets/css/"},
+		{"unclean", "/assets/../assets/css/main.css", "/images//bg.jpg", "../../images/bg.jpg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := FSRelPath(tt.from, tt.to); err != nil || got != tt.want {
+				t.Errorf("FSRelPath(%q, %q) = %q, %v, want %q", tt.from, tt.to, got, err, tt.want)
+			}
+		})
+	}
+	for _, names := range [][2]string{{"/ololo.html", "/index.html"}, {"/index.html", "/ololo.css"}} {
+		if _, err := FSRelPath(names[0], names[1]); !os.IsNotExist(err) {
+			t.Errorf("FSRelPath(%q, %q) error = %v, want not exist", names[0], names[1], err)
+		}
+	}
+}
+
+func TestFSInstallDefaults(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "etc", "existing.txt")
+	if err := os.MkdirAll(filepath.Dir(existing), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(existing, []byte("mine"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fresh := filepath.Join(dir, "etc", "app", "1.txt")
+
+	written, err := FSInstallDefaults(map[string]string{
+		"/assets/txt/1.txt": fresh,
+		"/index.html":       existing,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(written, []string{fresh}) {
+		t.Errorf("FSInstallDefaults() = %v, want %v", written, []string{fresh})
+	}
+	if b, _ := ioutil.ReadFile(fresh); string(b) != FSMustString(false, "/assets/txt/1.txt") {
+		t.Errorf("FSInstallDefaults() wrote %q", b)
+	}
+	if b, _ := ioutil.ReadFile(existing); string(b) != "mine" {
+		t.Errorf("FSInstallDefaults() overwrote an existing file with %q", b)
+	}
+	fi, err := os.Stat(fresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded, _ := FSStat("/assets/txt/1.txt")
+	if !fi.ModTime().Equal(embedded.ModTime()) {
+		t.Errorf("FSInstallDefaults() modtime = %v, want %v", fi.ModTime(), embedded.ModTime())
+	}
+
+	// Written again, nothing happens.
+	if written, err := FSInstallDefaults(map[string]string{"/assets/txt/1.txt": fresh}); err != nil || len(written) != 0 {
+		t.Errorf("FSInstallDefaults() = %v, %v, want nothing written", written, err)
+	}
+}
+
+func TestFSInstallDefaultsErrors(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	mapping := map[string]string{
+		"/ololo":            filepath.Join(dir, "missing.txt"),
+		"/assets/txt/1.txt": filepath.Join(file, "below-a-file.txt"),
+		"/index.html":       filepath.Join(dir, "ok.html"),
+	}
+	if os.Geteuid() != 0 {
+		readOnly := filepath.Join(dir, "read-only")
+		if err := os.Mkdir(readOnly, 0500); err != nil {
+			t.Fatal(err)
+		}
+		mapping["/generic.html"] = filepath.Join(readOnly, "generic.html")
+	}
+	written, err := FSInstallDefaults(mapping)
+	if err == nil {
+		t.Fatal("FSInstallDefaults() must err")
+	}
+	for _, want := range []string{"/ololo", "below-a-file.txt"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("FSInstallDefaults() error = %v, want it to mention %s", err, want)
+		}
+	}
+	if len(mapping) == 4 && !strings.Contains(err.Error(), "read-only") {
+		t.Errorf("FSInstallDefaults() error = %v, want it to mention read-only", err)
+	}
+	if !reflect.DeepEqual(written, []string{filepath.Join(dir, "ok.html")}) {
+		t.Errorf("FSInstallDefaults() = %v, want the other files written", written)
+	}
+}
+
+func TestFSInstallDefaultsConcurrent(t *testing.T) {
+	dir := t.TempDir()
+	mapping := map[string]string{}
+	for _, name := range []string{"/index.html", "/generic.html", "/elements.html", "/assets/txt/1.txt"} {
+		mapping[name] = filepath.Join(dir, filepath.FromSlash(name))
+	}
+	var wg sync.WaitGroup
+	results := make([][]string, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			written, err := FSInstallDefaults(mapping)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = written
+		}(i)
+	}
+	wg.Wait()
+	seen := map[string]int{}
+	for _, written := range results {
+		for _, dest := range written {
+			seen[dest]++
+		}
+	}
+	for name, dest := range mapping {
+		if seen[dest] != 1 {
+			t.Errorf("%s written %d times, want once", dest, seen[dest])
+		}
+		if b, _ := ioutil.ReadFile(dest); string(b) != FSMustString(false, name) {
+			t.Errorf("%s has wrong content", dest)
+		}
+	}
+}
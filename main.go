@@ -1,40 +1,284 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
 	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/sbstnsp/esc/embed"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "extract" {
+		extract(os.Args[2:])
+		return
+	}
+	// esc diff takes the flags and names of the run it compares with.
+	diff := len(os.Args) > 1 && os.Args[1] == "diff"
+	if diff {
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+	}
 	conf := &embed.Config{
 		Invocation: strings.Join(os.Args[1:], " "),
 	}
 
 	flag.StringVar(&conf.OutputFile, "o", "", "Output file, else stdout.")
 	flag.StringVar(&conf.Package, "pkg", "main", "Package.")
+	flag.StringVar(&conf.BuildTags, "tags", "", "Build constraint expression, e.g. \"enterprise && !oss\", written as //go:build line to the generated files.")
+	flag.StringVar(&conf.DevTag, "dev-tag", "", "Build tag, e.g. dev, selecting a variant of the output reading files from disk, written next to it.")
+	flag.StringVar(&conf.LocalEnv, "local-env", "", "Environment variable, e.g. ESC_USE_LOCAL, forcing local mode whatever the useLocal arguments when set to a true value such as 1.")
 	flag.StringVar(&conf.Prefix, "prefix", "", "Prefix to strip from filesnames.")
+	var groups []embed.Group
+	flag.Func("group", "Group of files, name=path, embedded in /name with functions like NameFS, e.g. templates=./tmpl; may be repeated.", func(s string) error {
+		name, dir, ok := strings.Cut(s, "=")
+		if !ok {
+			return errors.New("want name=path")
+		}
+		groups = append(groups, embed.Group{Name: name, Files: []string{dir}, Prefix: dir})
+		return nil
+	})
+	var mounts []embed.Mount
+	flag.Func("files", "Files embedded in a directory of their own, src=path:/dir, e.g. src=./web/dist:/static, with path removed from their names; may be repeated.", func(s string) error {
+		i := strings.LastIndex(s, ":")
+		if !strings.HasPrefix(s, "src=") || i < 0 || !strings.HasPrefix(s[i+1:], "/") {
+			return errors.New("want src=path:/dir")
+		}
+		mounts = append(mounts, embed.Mount{Src: s[len("src="):i], Dir: s[i+1:]})
+		return nil
+	})
+	flag.Func("virtual", "File embedded with generated contents, name=value, where value is the contents or, starting with @, a command whose output they are, e.g. /buildinfo.json=@git describe; may be repeated.", func(s string) error {
+		name, b, err := embed.ParseVirtualFile(s)
+		if err != nil {
+			return err
+		}
+		if conf.InlineFiles == nil {
+			conf.InlineFiles = make(map[string][]byte)
+		}
+		conf.InlineFiles[name] = b
+		return nil
+	})
 	flag.StringVar(&conf.Ignore, "ignore", "", "Regexp for files we should ignore (for example \\\\.DS_Store).")
 	flag.StringVar(&conf.Include, "include", "", "Regexp for files to include. Only files that match will be included.")
-	flag.StringVar(&conf.ModTime, "modtime", "", "Unix timestamp to override as modification time for all files.")
+	ignoreGlobs := flag.String("ignore-glob", "", "Comma separated globs, e.g. **/*.map, for files and directories we should ignore.")
+	includeGlobs := flag.String("include-glob", "", "Comma separated globs for files to include. Only files that match will be included.")
+	flag.StringVar(&conf.IgnoreFile, "ignore-file", "", "File with regexps for files we should ignore, one per line.")
+	flag.StringVar(&conf.IncludeFile, "include-file", "", "File with regexps for files to include, one per line.")
+	fileMode := flag.String("file-mode", "", "Octal permission bits, e.g. 0644, to override as mode for all files.")
+	flag.StringVar(&conf.ModTime, "modtime", "", "Unix timestamp or RFC 3339 time to override as modification time for all files, \"now\" or \"build\", the time of the last commit, with an optional offset such as -24h, or \"git\" for the time of their last commit. Defaults to $SOURCE_DATE_EPOCH.")
 	flag.BoolVar(&conf.Private, "private", false, "If true, do not export autogenerated functions.")
+	flag.StringVar(&conf.FunctionPrefix, "func-prefix", "", "Prefix of the autogenerated functions and types, e.g. Admin for AdminFS, overriding -private.")
+	flag.StringVar(&conf.IdentPrefix, "ident-prefix", "", "Prefix replacing _esc in unexported identifiers, so the output of several runs can share a package.")
+	flag.BoolVar(&conf.ZeroCopy, "zero-copy", false, "If true, embed files uncompressed as string constants returned by FSString and shared read-only by FSByte without copying.")
+	flag.StringVar(&conf.EncryptionKeyEnv, "encrypt-key-env", "", "Environment variable holding a hex encoded AES key of 16, 24 or 32 bytes to encrypt the compressed files with, read by esc and by the generated code at runtime unless FSSetKey is called.")
+	flag.BoolVar(&conf.Verify, "verify", false, "If true, check the content of every file against its SHA-256 hash when it is decompressed, failing its reads with a descriptive error if it is corrupted.")
 	flag.BoolVar(&conf.NoCompression, "no-compress", false, "If true, do not compress files.")
-	flag.Parse()
-	conf.Files = flag.Args()
+	flag.StringVar(&conf.Compression, "compression", "", "Format to compress files in: gzip, the default, brotli, which the output decodes with github.com/andybalholm/brotli, or zstd, which it decodes with github.com/klauspost/compress/zstd.")
+	cache := flag.Bool("cache", false, "If true, cache compressed files by content in the user cache directory, so only changed files are compressed again.")
+	flag.StringVar(&conf.ImportPath, "import-path", "", "Full import path of the generated package, checked against go.mod.")
+	flag.BoolVar(&conf.SkipModuleCheck, "skip-module-check", false, "If true, do not check -import-path against go.mod.")
+	flag.StringVar(&conf.Root, "root", "", "Directory absolute local paths are recorded relative to, defaults to the go.mod directory.")
+	flag.BoolVar(&conf.AbsolutePaths, "absolute-paths", false, "If true, record absolute local paths as given.")
+	flag.StringVar(&conf.FormatCompat, "format-compat", "", "Format profile: empty for goimports, v1 for output byte-identical whatever Go version esc is built with.")
+	flag.StringVar(&conf.FormatLocalPrefix, "local-prefix", "", "Import path prefix goimports groups after third-party imports.")
+	flag.BoolVar(&conf.Fingerprint, "fingerprint", false, "If true, also embed files under names including their content version, served as immutable by FSHandler.")
+	flag.BoolVar(&conf.MetadataOnly, "metadata-only", false, "If true, embed file metadata but not contents, which are loaded at runtime with FSSetFetch.")
+	flag.BoolVar(&conf.MutableMetadata, "mutable-metadata", false, "If true, add FSSetModTime to override modification times at runtime.")
+	flag.BoolVar(&conf.ParseTemplates, "parse-templates", false, "If true, also generate ParseTemplates parsing embedded files as html/template templates.")
+	flag.BoolVar(&conf.PathConstants, "path-constants", false, "If true, also generate a constant holding the canonical name of every embedded file, e.g. FileIndexHTML for /index.html.")
+	flag.BoolVar(&conf.Interface, "interface", false, "If true, also generate the FSAssets interface, FSInstance and the in-memory NewFSFake implementing it.")
+	flag.StringVar(&conf.WrapEmbedVar, "wrap-embed-var", "", "Name of an embed.FS variable in the output package to read file contents from instead of embedding them.")
+	flag.BoolVar(&conf.UseGoEmbed, "go-embed", false, "If true, write file contents to a data directory next to the output file and embed them with go:embed.")
+	flag.Int64Var(&conf.ShardSize, "shard-size", 0, "If positive, move embedded data to files next to the output file, <output>_000.go and so on, holding about this many bytes each.")
+	flag.BoolVar(&conf.Conformance, "conformance", false, "If true, also write a conformance test with the manifest of embedded files next to the output file.")
+	flag.BoolVar(&conf.GenerateExamples, "examples", false, "If true, also write runnable examples of the generated functions next to the output file.")
+	flag.BoolVar(&conf.JSONManifest, "json-manifest", false, "If true, also write the names, sizes, hashes, modification times and content types of the embedded files as JSON next to the output file.")
+	flag.BoolVar(&conf.Billy, "billy", false, "If true, also write BillyFS, copying the assets to a new in-memory billy.Filesystem, next to the output file.")
+	flag.BoolVar(&conf.Afero, "afero", false, "If true, also write AferoFs, adapting the assets to a read-only afero.Fs, next to the output file.")
+	flag.IntVar(&conf.InvocationLimit, "invocation-limit", 0, "Length the invocation recorded in the output is truncated to by eliding file arguments, 0 for the default, negative for no limit.")
+	flag.StringVar(&conf.LookupMode, "lookup-mode", "", "How the output looks up embedded names: map, the default, binary-search, which omits the map and its keys, or compact, which also stores all names and local paths in one string each.")
+	flag.BoolVar(&conf.CaseInsensitive, "case-insensitive", false, "If true, also find embedded files under names differing in case, e.g. /Logo.PNG for /logo.png, failing if two names differ in case only.")
+	flag.StringVar(&conf.Normalization, "normalize", "", "Unicode normalization form of embedded names, nfc, which lookups normalize names to as well, so files named on macOS are found under names written elsewhere.")
+	flag.BoolVar(&conf.SkipHidden, "skip-hidden", false, "If true, skip files and directories starting with a dot, such as .git or .DS_Store, in embedded directories.")
+	flag.BoolVar(&conf.GitIgnore, "gitignore", false, "If true, skip files and directories in embedded directories matched by the .gitignore files found in them.")
+	flag.IntVar(&conf.MaxDepth, "max-depth", 0, "If positive, how many levels to descend beneath every named directory: 1 embeds the files in it only, 2 also those in its subdirectories.")
+	flag.StringVar(&conf.Symlinks, "symlinks", "", "What to do with symlinks in embedded directories: follow, the default, skip or error.")
+	flag.StringVar(&conf.Encoding, "encoding", "", "How compressed data is written in the output: base64, the default, string, which makes the binary smaller and the output larger, packed, string with the data of all files in one literal, or sidecar, packed with the data in a .bin file next to the output file embedded with go:embed.")
+	flag.Func("minify", "Minifier of files of a media type, type=command, e.g. text/css=minify --type=css, run with a file on standard input, or type for the built-in one of application/json; may be repeated.", func(s string) error {
+		mediaType, command, _ := strings.Cut(s, "=")
+		if conf.Minifiers == nil {
+			conf.Minifiers = make(map[string]string)
+		}
+		conf.Minifiers[mediaType] = command
+		return nil
+	})
+	dualStorage := flag.String("dual-storage", "", "Comma separated globs of files, by embedded name, to embed uncompressed as well as compressed.")
+	flag.BoolVar(&conf.PrecompressedBrotli, "precompressed-brotli", false, "If true, embed <file>.br as the brotli variant of <file>, which FSGzipHandler serves to clients accepting brotli, instead of as a file.")
+	expandArchives := flag.String("expand-archives", "", "Comma separated globs of archives, by embedded name, to expand in place instead of embedding them as files.")
+	flag.BoolVar(&conf.KeepArchiveName, "keep-archive-name", false, "If true, mount expanded archives in a directory named like the archive without its extension.")
+	flag.BoolVar(&conf.StrictKeys, "strict-keys", false, "If true, fail instead of warning if an -expand-archives glob matches no embedded file.")
+	flag.Int64Var(&conf.MaxFileSize, "max-file-size", 0, "If positive, warn about embedded files larger than this many bytes.")
+	flag.Int64Var(&conf.MaxTotalSize, "max-total-size", 0, "If positive, warn if the embedded files are larger than this many bytes together.")
+	flag.BoolVar(&conf.StrictSizes, "strict-sizes", false, "If true, fail instead of warning if -max-file-size or -max-total-size is exceeded.")
+	configFile := flag.String("config", "", "JSON, YAML (.yaml, .yml) or TOML (.toml) file with Config fields, e.g. esc.json, which flags given explicitly and file arguments override.")
+	check := flag.Bool("check", false, "If true, do not write anything but fail if the output file or the files written next to it are not up to date.")
+	report := flag.Bool("report", false, "If true, print the size and embedded size of every file, largest first, and the totals on standard error.")
+	watch := flag.Bool("watch", false, "If true, regenerate the output file whenever the embedded files change, until interrupted.")
+	if err := embed.ParseFlags(flag.CommandLine, "config", os.Args[1:], conf); err != nil {
+		log.Fatal(err)
+	}
+	if flag.NArg() > 0 || *configFile == "" {
+		conf.Files = flag.Args()
+	}
+	if len(groups) > 0 {
+		conf.Groups = groups
+	}
+	if len(mounts) > 0 {
+		conf.Mounts = mounts
+	}
+	if *cache {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+		conf.CacheDir = filepath.Join(dir, "esc")
+	}
+	if *fileMode != "" {
+		mode, err := strconv.ParseUint(*fileMode, 8, 32)
+		if err != nil {
+			log.Fatalf("file-mode must be octal: %v", err)
+		}
+		conf.FileMode = os.FileMode(mode)
+	}
+	if *ignoreGlobs != "" {
+		conf.IgnoreGlobs = strings.Split(*ignoreGlobs, ",")
+	}
+	if *includeGlobs != "" {
+		conf.IncludeGlobs = strings.Split(*includeGlobs, ",")
+	}
+	if *dualStorage != "" {
+		conf.DualStorage = strings.Split(*dualStorage, ",")
+	}
+	if *expandArchives != "" {
+		conf.ExpandArchives = strings.Split(*expandArchives, ",")
+	}
+	if conf.EncryptionKeyEnv != "" {
+		key, err := hex.DecodeString(os.Getenv(conf.EncryptionKeyEnv))
+		if err != nil || len(key) == 0 {
+			log.Fatalf("$%s must hold a hex encoded AES key", conf.EncryptionKeyEnv)
+		}
+		conf.EncryptionKey = key
+	}
+	conf.Warn = func(msg string) {
+		fmt.Fprintln(os.Stderr, "esc: warning:", msg)
+	}
 
+	if diff {
+		diffs, err := embed.Diff(conf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, d := range diffs {
+			fmt.Println(d)
+		}
+		if len(diffs) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	if *check {
+		if err := embed.Check(conf); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		err := embed.Watch(ctx, conf, embed.WatchOptions{
+			OnRun: func(res *embed.RunResult, err error) {
+				if err != nil {
+					log.Print(err)
+					return
+				}
+				log.Printf("wrote %s with %d files", conf.OutputFile, res.Stats.Files)
+			},
+		})
+		if err != nil && err != context.Canceled {
+			log.Fatal(err)
+		}
+		return
+	}
+	var res *embed.RunResult
 	var err error
-	out := os.Stdout
-	if conf.OutputFile != "" {
-		if out, err = os.Create(conf.OutputFile); err != nil {
+	if conf.OutputFile == "" {
+		res, err = embed.RunWithResult(conf, os.Stdout)
+	} else {
+		res, err = writeOutput(conf)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *report {
+		if err := res.WriteReport(os.Stderr); err != nil {
 			log.Fatal(err)
 		}
-		defer out.Close()
 	}
+}
 
-	if err = embed.Run(conf, out); err != nil {
+// extract implements "esc extract [-o dir] file.go", writing the files
+// embedded in a generated file to a directory.
+func extract(args []string) {
+	fs := flag.NewFlagSet("extract", flag.ExitOnError)
+	dir := fs.String("o", ".", "Directory to write the embedded files to.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: esc extract [-o dir] file.go")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := embed.Extract(fs.Arg(0), *dir); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// writeOutput runs conf, streaming the output to a temporary file next to
+// the output file, which is only replaced once the run succeeded.
+func writeOutput(conf *embed.Config) (res *embed.RunResult, err error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(conf.OutputFile), "."+filepath.Base(conf.OutputFile)+".tmp")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	w := bufio.NewWriter(tmp)
+	if res, err = embed.RunWithResult(conf, w); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return nil, err
+	}
+	return res, os.Rename(tmp.Name(), conf.OutputFile)
+}
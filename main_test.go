@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// buildEsc builds esc into a temporary directory and returns its path.
+func buildEsc(t *testing.T) string {
+	t.Helper()
+	exe := filepath.Join(t.TempDir(), "esc")
+	if out, err := exec.Command("go", "build", "-o", exe, ".").CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+	return exe
+}
+
+func TestConfigWithFuncFlags(t *testing.T) {
+	exe := buildEsc(t)
+	for config, content := range map[string]string{
+		"esc.json": `{"Package": "assets", "Prefix": "static"}`,
+		"esc.yaml": "Package: assets\nPrefix: static\n",
+		"esc.toml": "Package = \"assets\"\nPrefix = \"static\"\n",
+	} {
+		root := t.TempDir()
+		files := map[string]string{
+			config:             content,
+			"static/data.json": "{\n  \"a\": 1\n}\n",
+			"tmpl/index.html":  "<html></html>",
+		}
+		for name, content := range files {
+			name = filepath.Join(root, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cmd := exec.Command(exe, "-config", config, "-minify", "application/json",
+			"-group", "templates=tmpl", "-virtual", "/version.txt=v1", "-o", "static.go", "static")
+		cmd.Dir = root
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("esc -config %s: %v\n%s", config, err, out)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(root, "static.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		src := string(b)
+		for _, want := range []string{"package assets", `"/data.json"`, `"/templates/index.html"`, `"/version.txt"`, "func TemplatesFS("} {
+			if !strings.Contains(src, want) {
+				t.Errorf("esc -config %s: output does not contain %s", config, want)
+			}
+		}
+	}
+}
+
+func TestWarningsOnStderr(t *testing.T) {
+	exe := buildEsc(t)
+	cmd := exec.Command(exe, "-o", "static.go", t.TempDir())
+	cmd.Dir = t.TempDir()
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("esc: %v\n%s", err, stderr.String())
+	}
+	if got := stderr.String(); got != "esc: warning: no files are embedded\n" {
+		t.Errorf("esc with no files wrote %q to standard error", got)
+	}
+}